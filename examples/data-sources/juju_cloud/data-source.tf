@@ -0,0 +1,12 @@
+data "juju_cloud" "this" {
+  name = "localhost"
+}
+
+resource "juju_model" "this" {
+  name = "development"
+
+  cloud {
+    name   = data.juju_cloud.this.name
+    region = data.juju_cloud.this.regions[0].name
+  }
+}
@@ -0,0 +1,13 @@
+data "juju_credential" "this" {
+  cloud = "localhost"
+  name  = "creddev"
+}
+
+resource "juju_model" "this" {
+  name       = "development"
+  credential = data.juju_credential.this.name
+
+  cloud {
+    name = data.juju_credential.this.cloud
+  }
+}
@@ -0,0 +1,10 @@
+data "juju_integration_data" "this" {
+  model       = juju_model.development.name
+  relation_id = juju_integration.this.relation_id
+
+  sensitive_keys = "(?i)(password|secret)"
+}
+
+output "database_hosts" {
+  value = [for unit in data.juju_integration_data.this.units : unit.data["host"] if unit.application == "percona-cluster"]
+}
@@ -0,0 +1,10 @@
+data "juju_model_status" "this" {
+  model = juju_model.development.name
+}
+
+check "applications_active" {
+  assert {
+    condition     = alltrue([for application in data.juju_model_status.this.applications : application.status == "active"])
+    error_message = "All applications in the model must be active."
+  }
+}
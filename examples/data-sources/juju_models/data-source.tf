@@ -0,0 +1,8 @@
+data "juju_models" "this" {
+  owner = "admin"
+  cloud = "localhost"
+}
+
+output "model_uuids" {
+  value = { for model in data.juju_models.this.models : model.qualified_name => model.uuid }
+}
@@ -0,0 +1,10 @@
+data "juju_offers" "databases" {
+  interface        = "pgsql"
+  role             = "provider"
+  allowed_consumer = "consumer-team"
+}
+
+resource "juju_saas" "database" {
+  model     = juju_model.development.name
+  offer_url = data.juju_offers.databases.offers[0].url
+}
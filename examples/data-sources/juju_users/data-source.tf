@@ -0,0 +1,16 @@
+data "juju_users" "all" {
+  include_disabled     = true
+  include_model_access = true
+}
+
+# fail the plan when a user has not connected for 90 days
+check "stale_users" {
+  assert {
+    condition = alltrue([
+      for user in data.juju_users.all.users :
+      user.disabled || user.last_connection == "" ||
+      timecmp(user.last_connection, timeadd(plantimestamp(), "-2160h")) > 0
+    ])
+    error_message = "Some users have not connected for 90 days, disable them."
+  }
+}
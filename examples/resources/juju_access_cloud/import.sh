@@ -0,0 +1,3 @@
+# Access Clouds can be imported using the cloud name,
+# access and comma separated list of users
+$ terraform import juju_access_cloud.this localhost:add-model:user-one,user-two
@@ -0,0 +1,3 @@
+# Access Controllers can be imported using controller,
+# the access and comma separated list of users
+$ terraform import juju_access_controller.this controller:superuser:user-one,user-two
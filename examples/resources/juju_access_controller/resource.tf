@@ -0,0 +1,4 @@
+resource "juju_access_controller" "this" {
+  access = "superuser"
+  users  = [juju_user.ops.name]
+}
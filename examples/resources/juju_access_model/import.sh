@@ -1,3 +1,5 @@
 # Access Models can be imported using the model name,
-# access and comma separated list of users
+# access and comma separated list of users, optionally
+# followed by the mode
 $ terraform import juju_access_model.development development:read:user-one,user-two
+$ terraform import juju_access_model.admins development:admin:user-one:authoritative
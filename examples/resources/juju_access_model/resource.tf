@@ -3,3 +3,12 @@ resource "juju_access_model" "this" {
   access = "write"
   users  = [juju_user.dev.name, juju_user.qa.name]
 }
+
+# the only admins of the model, besides its owner: other admins are
+# removed from the model and the access of the listed users is fixed
+resource "juju_access_model" "admins" {
+  model  = juju_model.dev.name
+  access = "admin"
+  mode   = "authoritative"
+  users  = [juju_user.ops.name]
+}
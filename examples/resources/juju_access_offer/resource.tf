@@ -0,0 +1,5 @@
+resource "juju_access_offer" "this" {
+  offer_url = juju_offer.database.url
+  access    = "consume"
+  users     = [juju_user.dev.name, juju_user.qa.name]
+}
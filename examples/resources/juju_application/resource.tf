@@ -15,6 +15,10 @@ resource "juju_application" "this" {
   config = {
     external-hostname = "..."
   }
+
+  annotations = {
+    owner = "platform"
+  }
 }
 
 resource "juju_application" "placement_example" {
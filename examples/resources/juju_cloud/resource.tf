@@ -0,0 +1,32 @@
+resource "juju_cloud" "this" {
+  name       = "lxd-remote"
+  type       = "lxd"
+  auth_types = ["certificate"]
+  endpoint   = "https://10.20.30.40:8443"
+
+  regions {
+    name = "default"
+  }
+
+  ca_certificates = [file("/srv/lxd-ca.crt")]
+
+  config = {
+    "default-series" = "jammy"
+  }
+}
+
+resource "juju_credential" "this" {
+  name = "lxd-remote"
+
+  cloud {
+    name = juju_cloud.this.name
+  }
+
+  auth_type = "certificate"
+
+  attribute_files = {
+    client-cert = "/srv/cert.crt"
+    client-key  = "/srv/cert.key"
+    server-cert = "/srv/server.crt"
+  }
+}
@@ -0,0 +1,4 @@
+# The controller configuration can be imported using the controller UUID.
+# The import adopts no configuration, the keys set in `config` are
+# managed from the next apply.
+$ terraform import juju_controller_config.this 3f6c8de5-2f39-4b65-8d4d-9a3e0f5e8b2a
@@ -0,0 +1,6 @@
+resource "juju_controller_config" "this" {
+  config = {
+    audit-log-max-backups  = "10"
+    max-debug-log-duration = "12h0m0s"
+  }
+}
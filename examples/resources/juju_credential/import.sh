@@ -3,3 +3,7 @@
 # Where false means that is not a client credential
 # and true means that is a Controller credential
 $ terraform import juju_credential.credential creddev:localhost:false:true
+
+# Controller credentials of the current user can also be imported
+# using their cloud/owner/name ID
+$ terraform import juju_credential.credential localhost/admin/creddev
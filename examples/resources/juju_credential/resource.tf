@@ -7,9 +7,44 @@ resource "juju_credential" "this" {
 
   auth_type = "certificate"
 
-  attributes = {
-    client-cert    = "/srv/cert.crt"
-    client-key     = "/srv/cert.key"
-    trust-password = "S0m3P@$$w0rd"
+  attribute_files = {
+    client-cert = "/srv/cert.crt"
+    client-key  = "/srv/cert.key"
+    server-cert = "/srv/server.crt"
+  }
+}
+
+resource "juju_credential" "gce" {
+  name = "gcedev"
+
+  cloud {
+    name = "google"
+  }
+
+  auth_type = "jsonfile"
+
+  # the JSON key is read from the file when applying, only its path
+  # and checksum are stored in the state
+  attribute_files = {
+    file = "/srv/gce-key.json"
+  }
+}
+
+# rotate the credential: the models using creddev are moved to
+# creddev-2 when it is created, creddev can then be removed
+resource "juju_credential" "rotated" {
+  name = "creddev-2"
+
+  cloud {
+    name = "localhost"
+  }
+
+  auth_type = "certificate"
+  replaces  = juju_credential.this.name
+
+  attribute_files = {
+    client-cert = "/srv/cert-2.crt"
+    client-key  = "/srv/cert-2.key"
+    server-cert = "/srv/server.crt"
   }
 }
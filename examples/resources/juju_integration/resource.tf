@@ -2,6 +2,8 @@ resource "juju_integration" "this" {
   model = juju_model.development.name
   via   = "10.0.0.0/24,10.0.1.0/24"
 
+  wait_for_status = true
+
   application {
     name     = juju_application.wordpress.name
     endpoint = "db"
@@ -12,3 +14,18 @@ resource "juju_integration" "this" {
     endpoint = "server"
   }
 }
+
+resource "juju_integration" "suspended" {
+  model = juju_model.development.name
+
+  suspended        = true
+  suspended_reason = "Consumer is being decommissioned"
+
+  application {
+    name = juju_application.wordpress.name
+  }
+
+  application {
+    offer_url = juju_offer.database.url
+  }
+}
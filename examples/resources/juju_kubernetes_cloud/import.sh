@@ -0,0 +1,3 @@
+# Kubernetes clouds can be imported using the cloud name, the
+# kubeconfig or credential attributes are then updated on apply
+$ terraform import juju_kubernetes_cloud.microk8s microk8s
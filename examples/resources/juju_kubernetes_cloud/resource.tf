@@ -0,0 +1,27 @@
+resource "juju_kubernetes_cloud" "microk8s" {
+  name              = "microk8s"
+  kubernetes_config = file("~/.kube/config")
+  context           = "microk8s"
+}
+
+resource "juju_kubernetes_cloud" "eks" {
+  name              = "eks"
+  host_cloud_region = "ec2/eu-west-1"
+  endpoint          = "https://ABCDEF.gr7.eu-west-1.eks.amazonaws.com"
+  ca_certificates   = [file("/srv/eks-ca.crt")]
+  credential_name   = "eks-admin"
+  auth_type         = "oauth2"
+
+  attributes = {
+    Token = var.eks_token
+  }
+}
+
+resource "juju_model" "this" {
+  name       = "development"
+  credential = juju_kubernetes_cloud.microk8s.credential_name
+
+  cloud {
+    name = juju_kubernetes_cloud.microk8s.name
+  }
+}
@@ -3,4 +3,8 @@ resource "juju_machine" "this_machine" {
   series      = "focal"
   name        = "this_machine"
   constraints = "tags=my-machine-tag"
-}
\ No newline at end of file
+
+  annotations = {
+    owner = "platform"
+  }
+}
@@ -12,4 +12,9 @@ resource "juju_model" "this" {
     no-proxy                    = "jujucharms.com"
     update-status-hook-interval = "5m"
   }
+
+  annotations = {
+    owner       = "platform"
+    cost-center = "1234"
+  }
 }
@@ -0,0 +1,5 @@
+# Model defaults can be imported using the cloud name,
+# or the cloud name and region separated by a colon.
+# All the defaults set in the cloud or region are imported.
+$ terraform import juju_model_defaults.aws aws
+$ terraform import juju_model_defaults.aws_eu_west_1 aws:eu-west-1
@@ -0,0 +1,17 @@
+resource "juju_model_defaults" "aws" {
+  cloud = "aws"
+
+  config = {
+    logging-config = "<root>=INFO"
+    no-proxy       = "jujucharms.com"
+  }
+}
+
+resource "juju_model_defaults" "aws_eu_west_1" {
+  cloud  = "aws"
+  region = "eu-west-1"
+
+  config = {
+    update-status-hook-interval = "10m"
+  }
+}
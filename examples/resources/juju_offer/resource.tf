@@ -1,7 +1,13 @@
 resource "juju_offer" "this" {
   model            = juju_model.development.name
   application_name = juju_application.percona-cluster.name
-  endpoint         = server
+  endpoints        = ["server", "server-admin"]
+  description      = "Database for the development web applications"
+
+  # fail instead of severing the integrations of consumers still
+  # using the offer after 10 minutes
+  destroy_timeout  = "10m"
+  force_on_timeout = false
 }
 
 // an offer can then be used in an integration as below:
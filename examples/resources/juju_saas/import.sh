@@ -0,0 +1,2 @@
+# SAAS applications can be imported by using the format: model_name:saas_name, for example:
+$ terraform import juju_saas.database development:database
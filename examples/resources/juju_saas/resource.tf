@@ -0,0 +1,19 @@
+resource "juju_saas" "database" {
+  model     = juju_model.development.name
+  offer_url = juju_offer.database.url
+  alias     = "database"
+}
+
+resource "juju_integration" "wordpress_db" {
+  model = juju_model.development.name
+
+  application {
+    name     = juju_application.wordpress.name
+    endpoint = "db"
+  }
+
+  application {
+    name     = juju_saas.database.name
+    endpoint = "server"
+  }
+}
@@ -1,2 +1,2 @@
-# Keys can be imported with the name of the model and the username of the key
-$ terraform import juju_ssh_key.dev-user ssh_key:development:dev-user
\ No newline at end of file
+# Keys can be imported with the name of the model and the fingerprint of the key
+$ terraform import juju_ssh_key.dev-user sshkey:development:00:1b:88:df:7b:ff:57:ab:91:ac:47:ff:94:2d:b0:94
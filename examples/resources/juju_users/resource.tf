@@ -3,3 +3,19 @@ resource "juju_user" "this" {
   display_name = format("%s - terraform managed", "dev-user")
   password     = var.password
 }
+
+# the user registers with `juju register <registration string>`
+resource "juju_user" "ops" {
+  name = "ops-user"
+}
+
+output "ops_registration" {
+  value     = juju_user.ops.registration_string
+  sensitive = true
+}
+
+resource "juju_user" "former" {
+  name     = "former-user"
+  password = var.former_password
+  disabled = true
+}
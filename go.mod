@@ -8,16 +8,18 @@ require (
 	github.com/hashicorp/terraform-plugin-sdk/v2 v2.26.1
 	// 2.9.42
 	github.com/juju/juju v0.0.0-20230228224222-7b871e782195
-
 )
 
 require (
 	github.com/juju/charm/v8 v8.0.6
+	github.com/juju/clock v1.0.2
 	github.com/juju/errors v1.0.0
+	github.com/juju/mutex/v2 v2.0.0
 	github.com/juju/names/v4 v4.0.0
 	github.com/juju/utils/v3 v3.0.2
 	github.com/pkg/errors v0.9.1
 	github.com/rs/zerolog v1.29.1
+	gopkg.in/yaml.v2 v2.4.0
 )
 
 require (
@@ -92,7 +94,6 @@ require (
 	github.com/juju/ansiterm v1.0.0 // indirect
 	github.com/juju/blobstore/v2 v2.0.0 // indirect
 	github.com/juju/charmrepo/v6 v6.0.3 // indirect
-	github.com/juju/clock v1.0.2 // indirect
 	github.com/juju/cmd/v3 v3.0.0 // indirect
 	github.com/juju/collections v1.0.2 // indirect
 	github.com/juju/description/v3 v3.0.13 // indirect
@@ -109,7 +110,6 @@ require (
 	github.com/juju/lru v0.0.0-20190314140547-92a0afabdc41 // indirect
 	github.com/juju/lumberjack/v2 v2.0.2 // indirect
 	github.com/juju/mgo/v2 v2.0.2 // indirect
-	github.com/juju/mutex/v2 v2.0.0 // indirect
 	github.com/juju/os/v2 v2.2.3 // indirect
 	github.com/juju/packaging/v2 v2.0.1 // indirect
 	github.com/juju/persistent-cookiejar v1.0.0 // indirect
@@ -199,7 +199,6 @@ require (
 	gopkg.in/retry.v1 v1.0.3 // indirect
 	gopkg.in/tomb.v1 v1.0.0-20141024135613-dd632973f1e7 // indirect
 	gopkg.in/tomb.v2 v2.0.0-20161208151619-d5d1b5820637 // indirect
-	gopkg.in/yaml.v2 v2.4.0 // indirect
 	gopkg.in/yaml.v3 v3.0.1 // indirect
 	k8s.io/api v0.23.4 // indirect
 	k8s.io/apiextensions-apiserver v0.21.10 // indirect
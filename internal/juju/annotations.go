@@ -0,0 +1,109 @@
+package juju
+
+import (
+	"fmt"
+
+	"github.com/juju/juju/api/client/annotations"
+	"github.com/juju/names/v4"
+)
+
+type annotationsClient struct {
+	ConnectionFactory
+}
+
+type GetAnnotationsInput struct {
+	ModelUUID string
+	EntityTag names.Tag
+}
+
+type GetAnnotationsResponse struct {
+	Annotations map[string]string
+}
+
+type SetAnnotationsInput struct {
+	ModelUUID   string
+	EntityTag   names.Tag
+	Annotations map[string]string
+	Unset       []string
+}
+
+func newAnnotationsClient(cf ConnectionFactory) *annotationsClient {
+	return &annotationsClient{
+		ConnectionFactory: cf,
+	}
+}
+
+// ForController returns an annotations client connecting to the
+// controller described by config. A nil config returns the current
+// client.
+func (c *annotationsClient) ForController(config *Configuration) *annotationsClient {
+	if config == nil {
+		return c
+	}
+	return newAnnotationsClient(ConnectionFactory{
+		config: *config,
+	})
+}
+
+func (c *annotationsClient) GetAnnotations(input GetAnnotationsInput) (*GetAnnotationsResponse, error) {
+	conn, err := c.GetConnection(&input.ModelUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	client := annotations.NewClient(conn)
+	defer client.Close()
+
+	results, err := client.Get([]string{input.EntityTag.String()})
+	if err != nil {
+		return nil, err
+	}
+	if len(results) != 1 {
+		return nil, fmt.Errorf("expected one annotations result, received %d", len(results))
+	}
+	if results[0].Error.Error != nil {
+		return nil, results[0].Error.Error
+	}
+
+	return &GetAnnotationsResponse{
+		Annotations: results[0].Annotations,
+	}, nil
+}
+
+// SetAnnotations sets the input annotations on the entity. Juju removes
+// an annotation when its value is empty, which is used for the keys to
+// unset.
+func (c *annotationsClient) SetAnnotations(input SetAnnotationsInput) error {
+	values := make(map[string]string, len(input.Annotations)+len(input.Unset))
+	for _, key := range input.Unset {
+		values[key] = ""
+	}
+	for key, value := range input.Annotations {
+		values[key] = value
+	}
+	if len(values) == 0 {
+		return nil
+	}
+
+	conn, err := c.GetConnection(&input.ModelUUID)
+	if err != nil {
+		return err
+	}
+
+	client := annotations.NewClient(conn)
+	defer client.Close()
+
+	results, err := client.Set(map[string]map[string]string{
+		input.EntityTag.String(): values,
+	})
+	if err != nil {
+		return err
+	}
+	for _, result := range results {
+		if result.Error != nil {
+			return result.Error
+		}
+	}
+
+	return nil
+}
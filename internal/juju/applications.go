@@ -71,8 +71,10 @@ func ConfigEntryToString(input interface{}) string {
 		return strconv.FormatInt(t, 10)
 	case float64:
 		return strconv.FormatFloat(t, 'f', 0, 64)
+	case string:
+		return t
 	default:
-		return input.(string)
+		return fmt.Sprintf("%v", input)
 	}
 }
 
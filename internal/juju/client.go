@@ -19,14 +19,18 @@ const (
 )
 
 type Configuration struct {
-	ControllerAddresses []string
-	Username            string
-	Password            string
-	CACert              string
+	ControllerAddresses   []string
+	Username              string
+	Password              string
+	CACert                string
+	ClientCredentialStore string
 }
 
 type Client struct {
+	Annotations  annotationsClient
 	Applications applicationsClient
+	Clouds       cloudsClient
+	Controllers  controllersClient
 	Machines     machinesClient
 	Credentials  credentialsClient
 	Integrations integrationsClient
@@ -46,7 +50,10 @@ func NewClient(config Configuration) (*Client, error) {
 	}
 
 	return &Client{
+		Annotations:  *newAnnotationsClient(cf),
 		Applications: *newApplicationClient(cf),
+		Clouds:       *newCloudsClient(cf),
+		Controllers:  *newControllersClient(cf),
 		Credentials:  *newCredentialsClient(cf),
 		Integrations: *newIntegrationsClient(cf),
 		Machines:     *newMachinesClient(cf),
@@ -0,0 +1,150 @@
+package juju
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/juju/clock"
+	"github.com/juju/errors"
+	jujucloud "github.com/juju/juju/cloud"
+	"github.com/juju/juju/jujuclient"
+	"github.com/juju/mutex/v2"
+	"github.com/juju/utils/v3"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	// ClientStoreMemory keeps the client credentials in memory for the
+	// duration of the provider run, leaving the local Juju client alone.
+	ClientStoreMemory = "memory"
+	// clientStoreLockTimeout is the time to wait for other applies
+	// writing to the same client store.
+	clientStoreLockTimeout = 30 * time.Second
+)
+
+// clientCredentialStore stores the credentials added to the client with
+// client_credential. Updates are read-modify-write cycles locked against
+// concurrent provider runs.
+type clientCredentialStore struct {
+	store jujuclient.CredentialStore
+	// lockName is the name of the machine wide mutex guarding updates
+	lockName string
+	// writeOnly is set for the in memory store, which starts empty on
+	// every run: the credentials of previous runs cannot be read back
+	writeOnly bool
+}
+
+// newClientCredentialStore returns the credential store at location: the
+// local Juju client store when empty, an in memory store for
+// ClientStoreMemory, or the credentials.yaml file of the location
+// directory otherwise.
+func newClientCredentialStore(location string) *clientCredentialStore {
+	var store jujuclient.CredentialStore
+	writeOnly := false
+	switch location {
+	case "":
+		store = jujuclient.NewFileCredentialStore()
+		location = jujuclient.JujuCredentialsPath()
+	case ClientStoreMemory:
+		store = jujuclient.NewMemStore()
+		writeOnly = true
+	default:
+		store = &dirCredentialStore{path: filepath.Join(location, "credentials.yaml")}
+	}
+
+	h := sha256.New()
+	_, _ = h.Write([]byte(location))
+	return &clientCredentialStore{
+		store:     store,
+		lockName:  fmt.Sprintf("tf-juju-credentials-%x", h.Sum(nil)[:4]),
+		writeOnly: writeOnly,
+	}
+}
+
+// update applies f to the credentials of the cloud and saves them. The
+// credentials are empty when the cloud has none yet.
+func (s *clientCredentialStore) update(cloudName string, f func(*jujucloud.CloudCredential) error) error {
+	releaser, err := mutex.Acquire(mutex.Spec{
+		Name:    s.lockName,
+		Clock:   clock.WallClock,
+		Delay:   20 * time.Millisecond,
+		Timeout: clientStoreLockTimeout,
+	})
+	if err != nil {
+		return errors.Annotate(err, "acquiring the client credentials lock")
+	}
+	defer releaser.Release()
+
+	credentials, err := s.store.CredentialForCloud(cloudName)
+	if errors.Is(err, errors.NotFound) {
+		credentials = &jujucloud.CloudCredential{}
+	} else if err != nil {
+		return errors.Annotate(err, "reading existing credentials for cloud")
+	}
+	if credentials.AuthCredentials == nil {
+		credentials.AuthCredentials = make(map[string]jujucloud.Credential)
+	}
+
+	if err := f(credentials); err != nil {
+		return err
+	}
+	return s.store.UpdateCredential(cloudName, *credentials)
+}
+
+// credentialForCloud returns the credentials of the cloud.
+func (s *clientCredentialStore) credentialForCloud(cloudName string) (*jujucloud.CloudCredential, error) {
+	return s.store.CredentialForCloud(cloudName)
+}
+
+// dirCredentialStore is a credential store using the credentials.yaml
+// file of a directory other than the Juju client data directory.
+type dirCredentialStore struct {
+	path string
+}
+
+// CredentialForCloud implements jujuclient.CredentialGetter.
+func (s *dirCredentialStore) CredentialForCloud(cloudName string) (*jujucloud.CloudCredential, error) {
+	credentials, err := jujuclient.ReadCredentialsFile(s.path)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return credentials.CloudCredential(cloudName)
+}
+
+// AllCredentials implements jujuclient.CredentialGetter.
+func (s *dirCredentialStore) AllCredentials() (map[string]jujucloud.CloudCredential, error) {
+	credentials, err := jujuclient.ReadCredentialsFile(s.path)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	all := make(map[string]jujucloud.CloudCredential)
+	for _, cloudName := range credentials.CloudNames() {
+		credential, err := credentials.CloudCredential(cloudName)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		all[cloudName] = *credential
+	}
+	return all, nil
+}
+
+// UpdateCredential implements jujuclient.CredentialUpdater.
+func (s *dirCredentialStore) UpdateCredential(cloudName string, details jujucloud.CloudCredential) error {
+	credentials, err := jujuclient.ReadCredentialsFile(s.path)
+	if err != nil {
+		return errors.Annotate(err, "cannot get credentials")
+	}
+	credentials.UpdateCloudCredential(cloudName, details)
+
+	data, err := yaml.Marshal(credentials)
+	if err != nil {
+		return errors.Annotate(err, "cannot marshal yaml credentials")
+	}
+	if err := os.MkdirAll(filepath.Dir(s.path), 0700); err != nil {
+		return errors.Trace(err)
+	}
+	return utils.AtomicWriteFile(s.path, data, os.FileMode(0600))
+}
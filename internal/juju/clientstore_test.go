@@ -0,0 +1,109 @@
+package juju
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/juju/errors"
+	jujucloud "github.com/juju/juju/cloud"
+)
+
+func addTestCredential(t *testing.T, store *clientCredentialStore, name string) {
+	err := store.update("localhost", func(credentials *jujucloud.CloudCredential) error {
+		credentials.AuthCredentials[name] = jujucloud.NewCredential(jujucloud.CertificateAuthType, map[string]string{"token": name})
+		return nil
+	})
+	if err != nil {
+		t.Errorf("unexpected error adding credential %s: %s", name, err)
+	}
+}
+
+func TestDirClientCredentialStore(t *testing.T) {
+	dir := t.TempDir()
+	store := newClientCredentialStore(dir)
+
+	if _, err := store.credentialForCloud("localhost"); !errors.Is(err, errors.NotFound) {
+		t.Fatalf("expected no credentials, got %v", err)
+	}
+
+	addTestCredential(t, store, "one")
+	addTestCredential(t, store, "two")
+
+	if _, err := os.Stat(filepath.Join(dir, "credentials.yaml")); err != nil {
+		t.Fatalf("expected the credentials file to be written: %s", err)
+	}
+
+	// a new store reads the credentials written to the directory
+	credentials, err := newClientCredentialStore(dir).credentialForCloud("localhost")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(credentials.AuthCredentials) != 2 || credentials.AuthCredentials["two"].Attributes()["token"] != "two" {
+		t.Errorf("unexpected credentials: %+v", credentials.AuthCredentials)
+	}
+}
+
+func TestMemoryClientCredentialStore(t *testing.T) {
+	store := newClientCredentialStore(ClientStoreMemory)
+
+	addTestCredential(t, store, "one")
+
+	credentials, err := store.credentialForCloud("localhost")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if _, ok := credentials.AuthCredentials["one"]; !ok {
+		t.Errorf("expected credential one, got %+v", credentials.AuthCredentials)
+	}
+
+	// the memory stores of different clients are independent
+	if _, err := newClientCredentialStore(ClientStoreMemory).credentialForCloud("localhost"); !errors.Is(err, errors.NotFound) {
+		t.Errorf("expected no credentials in a new memory store, got %v", err)
+	}
+}
+
+func TestMemoryClientCredentialStoreWriteOnly(t *testing.T) {
+	client := &credentialsClient{store: newClientCredentialStore(ClientStoreMemory)}
+	if client.ClientCredentialsReadable() {
+		t.Error("expected the memory store not to be readable")
+	}
+
+	// the credential was added by a previous run
+	if err := client.destroyClientCredential("localhost", "one"); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+
+	dirClient := &credentialsClient{store: newClientCredentialStore(t.TempDir())}
+	if !dirClient.ClientCredentialsReadable() {
+		t.Error("expected the directory store to be readable")
+	}
+	if err := dirClient.destroyClientCredential("localhost", "one"); err == nil {
+		t.Error("expected an error removing a missing credential")
+	}
+}
+
+func TestClientCredentialStoreConcurrentUpdates(t *testing.T) {
+	dir := t.TempDir()
+
+	var wg sync.WaitGroup
+	names := []string{"a", "b", "c", "d", "e", "f"}
+	for _, name := range names {
+		wg.Add(1)
+		go func(name string) {
+			defer wg.Done()
+			// each apply uses its own store on the same directory
+			addTestCredential(t, newClientCredentialStore(dir), name)
+		}(name)
+	}
+	wg.Wait()
+
+	credentials, err := newClientCredentialStore(dir).credentialForCloud("localhost")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(credentials.AuthCredentials) != len(names) {
+		t.Errorf("expected %d credentials, got %d", len(names), len(credentials.AuthCredentials))
+	}
+}
@@ -0,0 +1,459 @@
+package juju
+
+import (
+	"strings"
+
+	"github.com/juju/errors"
+	cloudapi "github.com/juju/juju/api/client/cloud"
+	"github.com/juju/juju/caas"
+	k8scloud "github.com/juju/juju/caas/kubernetes/cloud"
+	jujucloud "github.com/juju/juju/cloud"
+	"github.com/juju/names/v4"
+)
+
+// k8sCloudType is the type of the clouds added by juju add-k8s.
+const k8sCloudType = "kubernetes"
+
+type cloudsClient struct {
+	ConnectionFactory
+}
+
+type AddCloudInput struct {
+	Cloud jujucloud.Cloud
+	// Force adds a cloud of a type other than the one of the
+	// controller cloud
+	Force bool
+}
+
+type ReadCloudInput struct {
+	Name string
+}
+
+type ReadCloudResponse struct {
+	Cloud jujucloud.Cloud
+}
+
+type UpdateCloudInput struct {
+	Cloud jujucloud.Cloud
+}
+
+type DestroyCloudInput struct {
+	Name string
+}
+
+type GrantCloudInput struct {
+	Cloud  string
+	User   string
+	Access string
+}
+
+type ReadAccessCloudInput struct {
+	Cloud string
+}
+
+type ReadAccessCloudResponse struct {
+	// Users maps the users to their cloud access
+	Users map[string]string
+}
+
+type UpdateAccessCloudInput struct {
+	Cloud  string
+	Grant  []string
+	Revoke []string
+	Access string
+}
+
+type DestroyAccessCloudInput struct {
+	Cloud  string
+	Revoke []string
+	Access string
+}
+
+// KubernetesCloudInput describes a kubernetes cloud and its credential,
+// either through a kubeconfig or through the cluster and credential
+// attributes.
+type KubernetesCloudInput struct {
+	Name            string
+	HostCloudRegion string
+	// KubeConfig is the content of a kubeconfig file
+	KubeConfig string
+	// Context is the kubeconfig context of the cluster, the current
+	// context when empty
+	Context        string
+	Endpoint       string
+	CACertificates []string
+	SkipTLSVerify  bool
+	// CredentialName defaults to the name of the cloud
+	CredentialName string
+	AuthType       string
+	Attributes     map[string]string
+}
+
+type KubernetesCloudResponse struct {
+	Cloud          jujucloud.Cloud
+	CredentialName string
+	AuthType       string
+}
+
+type DestroyKubernetesCloudInput struct {
+	Name           string
+	CredentialName string
+}
+
+// cloudAPI is the part of the cloud facade used to manage kubernetes
+// clouds and their credentials.
+type cloudAPI interface {
+	AddCloud(cloud jujucloud.Cloud, force bool) error
+	UpdateCloud(cloud jujucloud.Cloud) error
+	RemoveCloud(cloud string) error
+	AddCredential(tag string, credential jujucloud.Credential) error
+	RevokeCredential(tag names.CloudCredentialTag, force bool) error
+}
+
+func newCloudsClient(cf ConnectionFactory) *cloudsClient {
+	return &cloudsClient{
+		ConnectionFactory: cf,
+	}
+}
+
+func (c *cloudsClient) AddCloud(input AddCloudInput) error {
+	conn, err := c.GetConnection(nil)
+	if err != nil {
+		return err
+	}
+
+	client := cloudapi.NewClient(conn)
+	defer client.Close()
+
+	return client.AddCloud(input.Cloud, input.Force)
+}
+
+func (c *cloudsClient) ReadCloud(input ReadCloudInput) (*ReadCloudResponse, error) {
+	conn, err := c.GetConnection(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	client := cloudapi.NewClient(conn)
+	defer client.Close()
+
+	cloud, err := client.Cloud(names.NewCloudTag(input.Name))
+	if err != nil {
+		return nil, err
+	}
+
+	return &ReadCloudResponse{Cloud: cloud}, nil
+}
+
+func (c *cloudsClient) UpdateCloud(input UpdateCloudInput) error {
+	conn, err := c.GetConnection(nil)
+	if err != nil {
+		return err
+	}
+
+	client := cloudapi.NewClient(conn)
+	defer client.Close()
+
+	return client.UpdateCloud(input.Cloud)
+}
+
+func (c *cloudsClient) DestroyCloud(input DestroyCloudInput) error {
+	conn, err := c.GetConnection(nil)
+	if err != nil {
+		return err
+	}
+
+	client := cloudapi.NewClient(conn)
+	defer client.Close()
+
+	return client.RemoveCloud(input.Name)
+}
+
+// GrantCloud grants the cloud access to the user. Granting an access the
+// user already has is not an error.
+func (c *cloudsClient) GrantCloud(input GrantCloudInput) error {
+	conn, err := c.GetConnection(nil)
+	if err != nil {
+		return err
+	}
+
+	client := cloudapi.NewClient(conn)
+	defer client.Close()
+
+	return ignoreAlreadyHasAccess(client.GrantCloud(input.User, input.Access, input.Cloud))
+}
+
+func (c *cloudsClient) ReadAccessCloud(input ReadAccessCloudInput) (*ReadAccessCloudResponse, error) {
+	conn, err := c.GetConnection(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	client := cloudapi.NewClient(conn)
+	defer client.Close()
+
+	users, err := cloudUsers(client, input.Cloud)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ReadAccessCloudResponse{Users: users}, nil
+}
+
+// cloudUsers returns the access of the users of the cloud.
+func cloudUsers(client *cloudapi.Client, cloud string) (map[string]string, error) {
+	infos, err := client.CloudInfo([]names.CloudTag{names.NewCloudTag(cloud)})
+	if err != nil {
+		return nil, err
+	}
+	if len(infos) != 1 {
+		return nil, errors.Errorf("expected 1 cloud info, got %d", len(infos))
+	}
+
+	users := make(map[string]string)
+	for user, info := range infos[0].Users {
+		users[user] = info.Access
+	}
+	return users, nil
+}
+
+// Note we revoke the access of the resource: revoking add-model removes
+// the cloud access of the user while revoking admin leaves the user with
+// add-model access. Users having a greater access than the resource are
+// left untouched, revoking add-model would remove their admin access.
+func (c *cloudsClient) UpdateAccessCloud(input UpdateAccessCloudInput) error {
+	conn, err := c.GetConnection(nil)
+	if err != nil {
+		return err
+	}
+
+	client := cloudapi.NewClient(conn)
+	defer client.Close()
+
+	if len(input.Revoke) > 0 {
+		users, err := cloudUsers(client, input.Cloud)
+		if err != nil {
+			return err
+		}
+		for _, user := range input.Revoke {
+			access, ok := users[user]
+			if !ok || CloudAccessLevel(access) > CloudAccessLevel(input.Access) {
+				continue
+			}
+			err := client.RevokeCloud(user, input.Access, input.Cloud)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, user := range input.Grant {
+		err := ignoreAlreadyHasAccess(client.GrantCloud(user, input.Access, input.Cloud))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *cloudsClient) DestroyAccessCloud(input DestroyAccessCloudInput) error {
+	return c.UpdateAccessCloud(UpdateAccessCloudInput{
+		Cloud:  input.Cloud,
+		Revoke: input.Revoke,
+		Access: input.Access,
+	})
+}
+
+// CloudAccessLevels are the cloud access levels, from the lowest.
+var CloudAccessLevels = []string{"add-model", "admin"}
+
+// CloudAccessLevel returns the index of the access in CloudAccessLevels,
+// -1 for no access.
+func CloudAccessLevel(access string) int {
+	return accessLevel(CloudAccessLevels, access)
+}
+
+// AddKubernetesCloud adds a kubernetes cloud and its credential to the
+// controller, like `juju add-k8s --controller` does without creating a
+// service account in the cluster: the credential is used as given.
+func (c *cloudsClient) AddKubernetesCloud(input KubernetesCloudInput) (*KubernetesCloudResponse, error) {
+	conn, err := c.GetConnection(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	client := cloudapi.NewClient(conn)
+	defer client.Close()
+
+	currentUser := strings.TrimPrefix(conn.AuthTag().String(), PrefixUser)
+	return addKubernetesCloud(client, currentUser, input)
+}
+
+// UpdateKubernetesCloud updates a kubernetes cloud and its credential.
+func (c *cloudsClient) UpdateKubernetesCloud(input KubernetesCloudInput) (*KubernetesCloudResponse, error) {
+	conn, err := c.GetConnection(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	client := cloudapi.NewClient(conn)
+	defer client.Close()
+
+	currentUser := strings.TrimPrefix(conn.AuthTag().String(), PrefixUser)
+	return updateKubernetesCloud(client, currentUser, input)
+}
+
+// DestroyKubernetesCloud revokes the credential of a kubernetes cloud and
+// removes the cloud from the controller.
+func (c *cloudsClient) DestroyKubernetesCloud(input DestroyKubernetesCloudInput) error {
+	conn, err := c.GetConnection(nil)
+	if err != nil {
+		return err
+	}
+
+	client := cloudapi.NewClient(conn)
+	defer client.Close()
+
+	currentUser := strings.TrimPrefix(conn.AuthTag().String(), PrefixUser)
+	return destroyKubernetesCloud(client, currentUser, input)
+}
+
+func addKubernetesCloud(client cloudAPI, owner string, input KubernetesCloudInput) (*KubernetesCloudResponse, error) {
+	cloud, credential, err := kubernetesCloudFromInput(input)
+	if err != nil {
+		return nil, err
+	}
+	credentialTag, err := kubernetesCredentialTag(input, owner)
+	if err != nil {
+		return nil, err
+	}
+
+	// No need to force this addition as k8s is special.
+	if err := client.AddCloud(cloud, false); err != nil {
+		return nil, errors.Annotatef(err, "adding cloud %s", cloud.Name)
+	}
+	if err := client.AddCredential(credentialTag.String(), credential); err != nil {
+		// remove the cloud, which would otherwise be left on the
+		// controller without being tracked
+		if removeErr := client.RemoveCloud(cloud.Name); removeErr != nil {
+			return nil, errors.Annotatef(err, "adding credential %s (removing cloud %s: %v)", credentialTag.Id(), cloud.Name, removeErr)
+		}
+		return nil, errors.Annotatef(err, "adding credential %s", credentialTag.Id())
+	}
+
+	return &KubernetesCloudResponse{
+		Cloud:          cloud,
+		CredentialName: credentialTag.Name(),
+		AuthType:       string(credential.AuthType()),
+	}, nil
+}
+
+func updateKubernetesCloud(client cloudAPI, owner string, input KubernetesCloudInput) (*KubernetesCloudResponse, error) {
+	cloud, credential, err := kubernetesCloudFromInput(input)
+	if err != nil {
+		return nil, err
+	}
+	credentialTag, err := kubernetesCredentialTag(input, owner)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := client.UpdateCloud(cloud); err != nil {
+		return nil, errors.Annotatef(err, "updating cloud %s", cloud.Name)
+	}
+	// Adding an existing credential updates it.
+	if err := client.AddCredential(credentialTag.String(), credential); err != nil {
+		return nil, errors.Annotatef(err, "updating credential %s", credentialTag.Id())
+	}
+
+	return &KubernetesCloudResponse{
+		Cloud:          cloud,
+		CredentialName: credentialTag.Name(),
+		AuthType:       string(credential.AuthType()),
+	}, nil
+}
+
+func destroyKubernetesCloud(client cloudAPI, owner string, input DestroyKubernetesCloudInput) error {
+	credentialTag, err := kubernetesCredentialTag(KubernetesCloudInput{
+		Name:           input.Name,
+		CredentialName: input.CredentialName,
+	}, owner)
+	if err != nil {
+		return err
+	}
+
+	if err := client.RevokeCredential(*credentialTag, false); err != nil && !errors.Is(err, errors.NotFound) {
+		return errors.Annotatef(err, "revoking credential %s", credentialTag.Id())
+	}
+	if err := client.RemoveCloud(input.Name); err != nil && !errors.Is(err, errors.NotFound) {
+		return errors.Annotatef(err, "removing cloud %s", input.Name)
+	}
+	return nil
+}
+
+func kubernetesCredentialTag(input KubernetesCloudInput, owner string) (*names.CloudCredentialTag, error) {
+	credentialName := input.CredentialName
+	if credentialName == "" {
+		credentialName = input.Name
+	}
+	return GetCloudCredentialTag(input.Name, owner, credentialName)
+}
+
+// kubernetesCloudFromInput builds the cloud and the credential from the
+// kubeconfig when given, from the cluster and credential attributes
+// otherwise.
+func kubernetesCloudFromInput(input KubernetesCloudInput) (jujucloud.Cloud, jujucloud.Credential, error) {
+	params := k8scloud.CloudParamaters{
+		Name:            input.Name,
+		HostCloudRegion: input.HostCloudRegion,
+	}
+	if params.HostCloudRegion == "" {
+		params.HostCloudRegion = caas.K8sCloudOther
+	}
+
+	if input.KubeConfig == "" {
+		if input.Endpoint == "" || input.AuthType == "" {
+			return jujucloud.Cloud{}, jujucloud.Credential{}, errors.NotValidf("kubernetes cloud %s without kubeconfig, endpoint or auth type", input.Name)
+		}
+		cloud := jujucloud.Cloud{
+			Name:            params.Name,
+			Type:            k8sCloudType,
+			HostCloudRegion: params.HostCloudRegion,
+			AuthTypes:       k8scloud.SupportedAuthTypes(),
+			Endpoint:        input.Endpoint,
+			CACertificates:  input.CACertificates,
+			SkipTLSVerify:   input.SkipTLSVerify,
+		}
+		credential := jujucloud.NewCredential(jujucloud.AuthType(input.AuthType), input.Attributes)
+		return cloud, credential, nil
+	}
+
+	config, err := k8scloud.ConfigFromReader(strings.NewReader(input.KubeConfig))
+	if err != nil {
+		return jujucloud.Cloud{}, jujucloud.Credential{}, err
+	}
+	contextName := input.Context
+	if contextName == "" {
+		contextName = config.CurrentContext
+	}
+	if contextName == "" {
+		return jujucloud.Cloud{}, jujucloud.Credential{}, errors.NotValidf("kubeconfig without current context and no context")
+	}
+
+	cloud, err := k8scloud.CloudFromKubeConfigContext(contextName, config, params)
+	if err != nil {
+		return jujucloud.Cloud{}, jujucloud.Credential{}, err
+	}
+	if cloud.SkipTLSVerify && len(cloud.CACertificates) > 0 && cloud.CACertificates[0] != "" {
+		return jujucloud.Cloud{}, jujucloud.Credential{}, errors.NotValidf("cloud with both skip-TLS-verify=true and CA certificates")
+	}
+	if len(cloud.CACertificates) == 1 && cloud.CACertificates[0] == "" {
+		cloud.CACertificates = nil
+	}
+	credential, err := k8scloud.CredentialFromKubeConfigContext(contextName, config)
+	if err != nil {
+		return jujucloud.Cloud{}, jujucloud.Credential{}, err
+	}
+	return cloud, credential, nil
+}
@@ -0,0 +1,240 @@
+package juju
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/juju/errors"
+	jujucloud "github.com/juju/juju/cloud"
+	"github.com/juju/names/v4"
+)
+
+type fakeCloudAPI struct {
+	clouds      map[string]jujucloud.Cloud
+	credentials map[string]jujucloud.Credential
+	// credentialErr is returned by AddCredential when set
+	credentialErr error
+}
+
+func newFakeCloudAPI() *fakeCloudAPI {
+	return &fakeCloudAPI{
+		clouds:      make(map[string]jujucloud.Cloud),
+		credentials: make(map[string]jujucloud.Credential),
+	}
+}
+
+func (f *fakeCloudAPI) AddCloud(cloud jujucloud.Cloud, force bool) error {
+	if _, ok := f.clouds[cloud.Name]; ok {
+		return errors.AlreadyExistsf("cloud %s", cloud.Name)
+	}
+	f.clouds[cloud.Name] = cloud
+	return nil
+}
+
+func (f *fakeCloudAPI) UpdateCloud(cloud jujucloud.Cloud) error {
+	if _, ok := f.clouds[cloud.Name]; !ok {
+		return errors.NotFoundf("cloud %s", cloud.Name)
+	}
+	f.clouds[cloud.Name] = cloud
+	return nil
+}
+
+func (f *fakeCloudAPI) RemoveCloud(cloud string) error {
+	if _, ok := f.clouds[cloud]; !ok {
+		return errors.NotFoundf("cloud %s", cloud)
+	}
+	delete(f.clouds, cloud)
+	return nil
+}
+
+func (f *fakeCloudAPI) AddCredential(tag string, credential jujucloud.Credential) error {
+	if f.credentialErr != nil {
+		return f.credentialErr
+	}
+	credentialTag, err := names.ParseCloudCredentialTag(tag)
+	if err != nil {
+		return err
+	}
+	if _, ok := f.clouds[credentialTag.Cloud().Id()]; !ok {
+		return errors.NotFoundf("cloud %s", credentialTag.Cloud().Id())
+	}
+	f.credentials[credentialTag.Id()] = credential
+	return nil
+}
+
+func (f *fakeCloudAPI) RevokeCredential(tag names.CloudCredentialTag, force bool) error {
+	if _, ok := f.credentials[tag.Id()]; !ok {
+		return errors.NotFoundf("credential %s", tag.Id())
+	}
+	delete(f.credentials, tag.Id())
+	return nil
+}
+
+func testKubeConfig(t *testing.T) string {
+	data, err := os.ReadFile("testdata/kubeconfig.yaml")
+	if err != nil {
+		t.Fatalf("reading kubeconfig fixture: %s", err)
+	}
+	return string(data)
+}
+
+func TestAddKubernetesCloudFromKubeConfig(t *testing.T) {
+	client := newFakeCloudAPI()
+
+	response, err := addKubernetesCloud(client, "admin", KubernetesCloudInput{
+		Name:       "microk8s",
+		KubeConfig: testKubeConfig(t),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	cloud, ok := client.clouds["microk8s"]
+	if !ok {
+		t.Fatalf("cloud microk8s not added")
+	}
+	if cloud.Type != "kubernetes" {
+		t.Errorf("expected cloud type kubernetes, got %q", cloud.Type)
+	}
+	if cloud.Endpoint != "https://10.0.0.10:16443" {
+		t.Errorf("unexpected endpoint %q", cloud.Endpoint)
+	}
+	if cloud.HostCloudRegion != "other" {
+		t.Errorf("expected host cloud region other, got %q", cloud.HostCloudRegion)
+	}
+	if len(cloud.CACertificates) != 1 || cloud.CACertificates[0] != "-----BEGIN CERTIFICATE-----\nfake-ca\n-----END CERTIFICATE-----\n" {
+		t.Errorf("unexpected CA certificates %q", cloud.CACertificates)
+	}
+
+	if response.CredentialName != "microk8s" || response.AuthType != "clientcertificate" {
+		t.Errorf("unexpected credential %s with auth type %s", response.CredentialName, response.AuthType)
+	}
+	credential, ok := client.credentials["microk8s/admin/microk8s"]
+	if !ok {
+		t.Fatalf("credential microk8s/admin/microk8s not added")
+	}
+	if credential.Attributes()["ClientKeyData"] != "fake-client-key" {
+		t.Errorf("unexpected credential attributes %v", credential.Attributes())
+	}
+}
+
+func TestAddKubernetesCloudFromKubeConfigContext(t *testing.T) {
+	client := newFakeCloudAPI()
+
+	response, err := addKubernetesCloud(client, "admin", KubernetesCloudInput{
+		Name:            "eks",
+		KubeConfig:      testKubeConfig(t),
+		Context:         "eks",
+		HostCloudRegion: "ec2/eu-west-1",
+		CredentialName:  "eks-admin",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	cloud := client.clouds["eks"]
+	if !cloud.SkipTLSVerify || len(cloud.CACertificates) != 0 {
+		t.Errorf("expected TLS verification skipped without CA certificates, got %v and %q", cloud.SkipTLSVerify, cloud.CACertificates)
+	}
+	if cloud.HostCloudRegion != "ec2/eu-west-1" {
+		t.Errorf("unexpected host cloud region %q", cloud.HostCloudRegion)
+	}
+	if response.AuthType != "oauth2" {
+		t.Errorf("expected auth type oauth2, got %s", response.AuthType)
+	}
+	if client.credentials["eks/admin/eks-admin"].Attributes()["Token"] != "fake-token" {
+		t.Errorf("token credential not added")
+	}
+}
+
+func TestAddKubernetesCloudFromAttributes(t *testing.T) {
+	client := newFakeCloudAPI()
+
+	_, err := addKubernetesCloud(client, "admin", KubernetesCloudInput{
+		Name:     "k8s",
+		Endpoint: "https://10.0.0.20:6443",
+		AuthType: "oauth2",
+		Attributes: map[string]string{
+			"Token": "fake-token",
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if client.clouds["k8s"].Endpoint != "https://10.0.0.20:6443" {
+		t.Errorf("cloud k8s not added")
+	}
+	if client.credentials["k8s/admin/k8s"].Attributes()["Token"] != "fake-token" {
+		t.Errorf("credential k8s not added")
+	}
+
+	_, err = addKubernetesCloud(client, "admin", KubernetesCloudInput{Name: "invalid"})
+	if !errors.Is(err, errors.NotValid) {
+		t.Errorf("expected not valid error without kubeconfig nor endpoint, got %v", err)
+	}
+}
+
+func TestAddKubernetesCloudCredentialFailure(t *testing.T) {
+	client := newFakeCloudAPI()
+	client.credentialErr = errors.New("credential rejected")
+
+	_, err := addKubernetesCloud(client, "admin", KubernetesCloudInput{
+		Name:     "k8s",
+		Endpoint: "https://10.0.0.20:6443",
+		AuthType: "oauth2",
+		Attributes: map[string]string{
+			"Token": "fake-token",
+		},
+	})
+	if err == nil || !strings.Contains(err.Error(), "credential rejected") {
+		t.Fatalf("expected the credential error, got %v", err)
+	}
+	if _, ok := client.clouds["k8s"]; ok {
+		t.Errorf("expected cloud k8s removed after the credential failure")
+	}
+}
+
+func TestKubernetesCloudFromKubeConfigUnknownContext(t *testing.T) {
+	_, _, err := kubernetesCloudFromInput(KubernetesCloudInput{
+		Name:       "microk8s",
+		KubeConfig: testKubeConfig(t),
+		Context:    "gke",
+	})
+	if !errors.Is(err, errors.NotFound) {
+		t.Errorf("expected not found error, got %v", err)
+	}
+}
+
+func TestUpdateAndDestroyKubernetesCloud(t *testing.T) {
+	client := newFakeCloudAPI()
+	input := KubernetesCloudInput{
+		Name:       "microk8s",
+		KubeConfig: testKubeConfig(t),
+	}
+	if _, err := addKubernetesCloud(client, "admin", input); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	input.Context = "eks"
+	if _, err := updateKubernetesCloud(client, "admin", input); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if client.clouds["microk8s"].Endpoint != "https://ABCDEF.gr7.eu-west-1.eks.amazonaws.com" {
+		t.Errorf("cloud not updated, endpoint %q", client.clouds["microk8s"].Endpoint)
+	}
+	if client.credentials["microk8s/admin/microk8s"].AuthType() != "oauth2" {
+		t.Errorf("credential not updated")
+	}
+
+	if err := destroyKubernetesCloud(client, "admin", DestroyKubernetesCloudInput{Name: "microk8s"}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(client.clouds) != 0 || len(client.credentials) != 0 {
+		t.Errorf("expected cloud and credential removed, got %v and %v", client.clouds, client.credentials)
+	}
+	// destroying again is a no-op
+	if err := destroyKubernetesCloud(client, "admin", DestroyKubernetesCloudInput{Name: "microk8s"}); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+}
@@ -0,0 +1,199 @@
+package juju
+
+import (
+	"strings"
+
+	apicontroller "github.com/juju/juju/api/controller/controller"
+	"github.com/juju/juju/rpc/params"
+)
+
+type controllersClient struct {
+	ConnectionFactory
+}
+
+type ReadControllerConfigResponse struct {
+	ControllerUUID string
+	Config         map[string]interface{}
+}
+
+type UpdateControllerConfigInput struct {
+	Config map[string]interface{}
+}
+
+type GrantControllerInput struct {
+	User   string
+	Access string
+}
+
+type ReadAccessControllerInput struct {
+	Users []string
+}
+
+type ReadAccessControllerResponse struct {
+	// Users maps the users to their controller access
+	Users map[string]string
+}
+
+type UpdateAccessControllerInput struct {
+	Grant  []string
+	Revoke []string
+	Access string
+}
+
+type DestroyAccessControllerInput struct {
+	Revoke []string
+	Access string
+}
+
+func newControllersClient(cf ConnectionFactory) *controllersClient {
+	return &controllersClient{
+		ConnectionFactory: cf,
+	}
+}
+
+func (c *controllersClient) ReadControllerConfig() (*ReadControllerConfigResponse, error) {
+	conn, err := c.GetConnection(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	client := apicontroller.NewClient(conn)
+	defer client.Close()
+
+	config, err := client.ControllerConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	return &ReadControllerConfigResponse{
+		ControllerUUID: config.ControllerUUID(),
+		Config:         config,
+	}, nil
+}
+
+// UpdateControllerConfig sets the given controller configuration values.
+// Juju does not support unsetting controller configuration, any key not
+// passed keeps its current value.
+func (c *controllersClient) UpdateControllerConfig(input UpdateControllerConfigInput) error {
+	conn, err := c.GetConnection(nil)
+	if err != nil {
+		return err
+	}
+
+	client := apicontroller.NewClient(conn)
+	defer client.Close()
+
+	return client.ConfigSet(input.Config)
+}
+
+// GrantController grants the controller access to the user. Granting an
+// access the user already has, e.g. login to any user, is not an error.
+func (c *controllersClient) GrantController(input GrantControllerInput) error {
+	conn, err := c.GetConnection(nil)
+	if err != nil {
+		return err
+	}
+
+	client := apicontroller.NewClient(conn)
+	defer client.Close()
+
+	return ignoreAlreadyHasAccess(client.GrantController(input.User, input.Access))
+}
+
+func (c *controllersClient) ReadAccessController(input ReadAccessControllerInput) (*ReadAccessControllerResponse, error) {
+	conn, err := c.GetConnection(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	client := apicontroller.NewClient(conn)
+	defer client.Close()
+
+	users := make(map[string]string)
+	for _, user := range input.Users {
+		access, err := controllerAccess(client, user)
+		if err != nil {
+			return nil, err
+		}
+		if access != "" {
+			users[user] = access
+		}
+	}
+
+	return &ReadAccessControllerResponse{Users: users}, nil
+}
+
+// controllerAccess returns the controller access of the user, empty when
+// the user has no access or no longer exists.
+func controllerAccess(client *apicontroller.Client, user string) (string, error) {
+	access, err := client.GetControllerAccess(user)
+	if params.IsCodeNotFound(err) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return string(access), nil
+}
+
+// Note we revoke the access of the resource: revoking login removes the
+// controller access of the user while revoking superuser leaves the user
+// with login access. Users having a greater access than the resource are
+// left untouched, revoking login would remove their superuser access.
+func (c *controllersClient) UpdateAccessController(input UpdateAccessControllerInput) error {
+	conn, err := c.GetConnection(nil)
+	if err != nil {
+		return err
+	}
+
+	client := apicontroller.NewClient(conn)
+	defer client.Close()
+
+	for _, user := range input.Revoke {
+		access, err := controllerAccess(client, user)
+		if err != nil {
+			return err
+		}
+		if access == "" || ControllerAccessLevel(access) > ControllerAccessLevel(input.Access) {
+			continue
+		}
+		err = client.RevokeController(user, input.Access)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, user := range input.Grant {
+		err := ignoreAlreadyHasAccess(client.GrantController(user, input.Access))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *controllersClient) DestroyAccessController(input DestroyAccessControllerInput) error {
+	return c.UpdateAccessController(UpdateAccessControllerInput{
+		Revoke: input.Revoke,
+		Access: input.Access,
+	})
+}
+
+// ControllerAccessLevels are the controller access levels, from the lowest.
+var ControllerAccessLevels = []string{"login", "superuser"}
+
+// ControllerAccessLevel returns the index of the access in
+// ControllerAccessLevels, -1 for no access.
+func ControllerAccessLevel(access string) int {
+	return accessLevel(ControllerAccessLevels, access)
+}
+
+// ignoreAlreadyHasAccess ignores the error returned by Juju when granting
+// an access the user already has, or a greater one.
+func ignoreAlreadyHasAccess(err error) error {
+	if err != nil && strings.Contains(err.Error(), "already has") {
+		return nil
+	}
+	return err
+}
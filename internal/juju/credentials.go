@@ -2,21 +2,30 @@ package juju
 
 import (
 	"fmt"
+	"os"
+	"sort"
 	"strings"
 
 	"github.com/juju/errors"
+	"github.com/juju/juju/api/base"
 	cloudapi "github.com/juju/juju/api/client/cloud"
+	"github.com/juju/juju/api/client/modelmanager"
+	// register the kubernetes provider for its credential schemas
+	_ "github.com/juju/juju/caas/kubernetes/provider"
 	jujucloud "github.com/juju/juju/cloud"
-	"github.com/juju/juju/jujuclient"
+	"github.com/juju/juju/environs"
+	"github.com/juju/juju/rpc/params"
 	"github.com/juju/names/v4"
 )
 
 type credentialsClient struct {
 	ConnectionFactory
+	store *clientCredentialStore
 }
 
 type CreateCredentialInput struct {
 	Attributes           map[string]string
+	AttributeFiles       map[string]string
 	AuthType             string
 	ClientCredential     bool
 	CloudList            []interface{}
@@ -42,6 +51,7 @@ type ReadCredentialResponse struct {
 
 type UpdateCredentialInput struct {
 	Attributes           map[string]string
+	AttributeFiles       map[string]string
 	AuthType             string
 	ClientCredential     bool
 	CloudName            string
@@ -54,11 +64,73 @@ type DestroyCredentialInput struct {
 	CloudName            string
 	ControllerCredential bool
 	Name                 string
+	// Force revokes the controller credential even when models use it
+	Force bool
+}
+
+type ReadControllerCredentialInput struct {
+	CloudName string
+	Name      string
+}
+
+type ReadControllerCredentialResponse struct {
+	Name       string
+	CloudName  string
+	Owner      string
+	AuthType   string
+	Valid      bool
+	Attributes map[string]string
+	Models     []string
+}
+
+type ReplaceModelCredentialInput struct {
+	CloudName string
+	// Name of the credential the models are moved away from
+	Name string
+	// Replacement is the name of the credential the models now use
+	Replacement string
+}
+
+type ReplaceModelCredentialResponse struct {
+	Models []string
+}
+
+// CredentialInUseError is returned when destroying a controller
+// credential still used by models.
+type CredentialInUseError struct {
+	CloudName string
+	Name      string
+	Models    []string
+}
+
+func (e *CredentialInUseError) Error() string {
+	return fmt.Sprintf("credential %s on cloud %s is used by models %s", e.Name, e.CloudName, strings.Join(e.Models, ", "))
+}
+
+type ValidateCredentialAttributesInput struct {
+	CloudName  string
+	AuthType   string
+	Attributes []string
+}
+
+// credentialRevokeAPI is the part of the Cloud facade used to revoke a
+// controller credential and find the models using it.
+type credentialRevokeAPI interface {
+	CredentialContents(cloud, credential string, withSecrets bool) ([]params.CredentialContentResult, error)
+	RevokeCredential(tag names.CloudCredentialTag, force bool) error
+}
+
+// modelCredentialAPI is the part of the ModelManager facade used to
+// change the credential of models.
+type modelCredentialAPI interface {
+	ListModelSummaries(user string, all bool) ([]base.UserModelSummary, error)
+	ChangeModelCredential(model names.ModelTag, credential names.CloudCredentialTag) error
 }
 
 func newCredentialsClient(cf ConnectionFactory) *credentialsClient {
 	return &credentialsClient{
 		ConnectionFactory: cf,
+		store:             newClientCredentialStore(cf.config.ClientCredentialStore),
 	}
 }
 
@@ -104,6 +176,139 @@ func (c *credentialsClient) ValidateCredentialForCloud(cloudName, authTypeReceiv
 	return nil
 }
 
+// CredentialSchemas returns the credential schemas of the provider of the
+// cloud. Schemas are known for the providers built into the plugin and
+// the providers of knownCredentialSchemas, nil is returned for the others.
+func (c *credentialsClient) CredentialSchemas(cloudName string) (map[jujucloud.AuthType]jujucloud.CredentialSchema, error) {
+	conn, err := c.GetConnection(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	client := cloudapi.NewClient(conn)
+	defer client.Close()
+
+	cloud, err := client.Cloud(names.NewCloudTag(cloudName))
+	if err != nil {
+		return nil, err
+	}
+
+	provider, err := environs.Provider(cloud.Type)
+	if errors.Is(err, errors.NotFound) {
+		return knownCredentialSchemas[cloud.Type], nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return provider.CredentialSchemas(), nil
+}
+
+// ValidateCredentialAttributes checks the names of the attributes of a
+// credential against the credential schema of its cloud, when known. A
+// cloud not added to the controller yet, e.g. by a juju_cloud of the same
+// plan, is not validated.
+func (c *credentialsClient) ValidateCredentialAttributes(input ValidateCredentialAttributesInput) error {
+	schemas, err := c.CredentialSchemas(input.CloudName)
+	if errors.Is(err, errors.NotFound) {
+		return nil
+	}
+	if err != nil || schemas == nil {
+		return err
+	}
+	// an unsupported auth type is reported when the credential is added,
+	// listing the auth types of the cloud
+	schema, ok := schemas[jujucloud.AuthType(input.AuthType)]
+	if !ok {
+		return nil
+	}
+	return validateCredentialAttributes(schema, input.Attributes)
+}
+
+// validateCredentialAttributes reports the unknown attributes and the
+// missing mandatory ones. An attribute may be given through its file
+// attribute instead.
+func validateCredentialAttributes(schema jujucloud.CredentialSchema, attributes []string) error {
+	given := make(map[string]bool, len(attributes))
+	for _, name := range attributes {
+		given[name] = true
+	}
+
+	known := make(map[string]bool)
+	var missing []string
+	for _, field := range schema {
+		known[field.Name] = true
+		if field.FileAttr != "" {
+			known[field.FileAttr] = true
+		}
+		if field.Optional || given[field.Name] || (field.FileAttr != "" && given[field.FileAttr]) {
+			continue
+		}
+		missing = append(missing, field.Name)
+	}
+
+	var unknown []string
+	for _, name := range attributes {
+		if !known[name] {
+			unknown = append(unknown, name)
+		}
+	}
+	sort.Strings(unknown)
+
+	var problems []string
+	if len(missing) > 0 {
+		problems = append(problems, fmt.Sprintf("missing attributes %s", strings.Join(missing, ", ")))
+	}
+	if len(unknown) > 0 {
+		problems = append(problems, fmt.Sprintf("unknown attributes %s", strings.Join(unknown, ", ")))
+	}
+	if len(problems) > 0 {
+		return errors.NotValidf("credential attributes: %s", strings.Join(problems, "; "))
+	}
+	return nil
+}
+
+// finalizeAttributes reads the attributes held in files and, when the
+// credential schema of the cloud is known, resolves the file attributes
+// of the schema the way the Juju CLI does.
+func (c *credentialsClient) finalizeAttributes(cloudName, authType string, attributes, attributeFiles map[string]string) (map[string]string, error) {
+	schemas, err := c.CredentialSchemas(cloudName)
+	if err != nil {
+		return nil, err
+	}
+	var schema jujucloud.CredentialSchema
+	if schemas != nil {
+		var ok bool
+		if schema, ok = schemas[jujucloud.AuthType(authType)]; !ok {
+			return nil, errors.NotSupportedf("auth-type %q", authType)
+		}
+	}
+
+	finalized := make(map[string]string, len(attributes)+len(attributeFiles))
+	for name, value := range attributes {
+		finalized[name] = value
+	}
+	for name, path := range attributeFiles {
+		if _, exists := finalized[name]; exists {
+			return nil, errors.NotValidf("attribute %q set both as a value and a file", name)
+		}
+		// the schema reads the attributes holding a file path itself
+		if field, ok := schema.Attribute(name); ok && field.FilePath {
+			finalized[name] = path
+			continue
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, errors.Annotatef(err, "reading file for attribute %q", name)
+		}
+		finalized[name] = string(data)
+	}
+
+	if schema == nil {
+		return finalized, nil
+	}
+	return schema.Finalize(finalized, os.ReadFile)
+}
+
 func (c *credentialsClient) CreateCredential(input CreateCredentialInput) (*CreateCredentialResponse, error) {
 	if !input.ControllerCredential && !input.ClientCredential {
 		// Just in case none of them are set
@@ -125,6 +330,11 @@ func (c *credentialsClient) CreateCredential(input CreateCredentialInput) (*Crea
 		return nil, err
 	}
 
+	attributes, err := c.finalizeAttributes(cloudName, input.AuthType, input.Attributes, input.AttributeFiles)
+	if err != nil {
+		return nil, err
+	}
+
 	conn, err := c.GetConnection(nil)
 	if err != nil {
 		return nil, err
@@ -143,12 +353,12 @@ func (c *credentialsClient) CreateCredential(input CreateCredentialInput) (*Crea
 	cloudCredential := jujucloud.NewNamedCredential(
 		credentialName,
 		jujucloud.AuthType(input.AuthType),
-		input.Attributes,
+		attributes,
 		false,
 	)
 
 	if input.ClientCredential {
-		if err := updateClientCredential(cloudName, credentialName, cloudCredential); err != nil {
+		if err := c.updateClientCredential(cloudName, credentialName, cloudCredential); err != nil {
 			return nil, err
 		}
 	}
@@ -162,8 +372,17 @@ func (c *credentialsClient) CreateCredential(input CreateCredentialInput) (*Crea
 	return &CreateCredentialResponse{CloudCredential: cloudCredential, CloudName: cloudName}, nil
 }
 
+// ClientCredentialsReadable reports whether the client credentials added
+// by previous runs can be read back, which is not the case of the in
+// memory client credential store.
+func (c *credentialsClient) ClientCredentialsReadable() bool {
+	return !c.store.writeOnly
+}
+
+// ReadCredential reads the controller credential and the client credential
+// of the input. The client credential is not read from a write-only store.
 func (c *credentialsClient) ReadCredential(input ReadCredentialInput) (*ReadCredentialResponse, error) {
-	clientCredential := input.ClientCredential
+	clientCredential := input.ClientCredential && c.ClientCredentialsReadable()
 	cloudName := input.CloudName
 	controllerCredential := input.ControllerCredential
 	credentialName := input.Name
@@ -178,7 +397,7 @@ func (c *credentialsClient) ReadCredential(input ReadCredentialInput) (*ReadCred
 
 	var clientCredentialFound jujucloud.Credential
 	if clientCredential {
-		existingCredentials, err := getExistingClientCredential(cloudName)
+		existingCredentials, err := c.getExistingClientCredential(cloudName)
 		if err != nil {
 			return nil, err
 		}
@@ -249,6 +468,11 @@ func (c *credentialsClient) UpdateCredential(input UpdateCredentialInput) error
 		return err
 	}
 
+	attributes, err := c.finalizeAttributes(cloudName, input.AuthType, input.Attributes, input.AttributeFiles)
+	if err != nil {
+		return err
+	}
+
 	conn, err := c.GetConnection(nil)
 	if err != nil {
 		return err
@@ -267,12 +491,12 @@ func (c *credentialsClient) UpdateCredential(input UpdateCredentialInput) error
 	cloudCredential := jujucloud.NewNamedCredential(
 		input.Name,
 		jujucloud.AuthType(input.AuthType),
-		input.Attributes,
+		attributes,
 		false,
 	)
 
 	if input.ClientCredential {
-		if err := updateClientCredential(cloudName, credentialName, cloudCredential); err != nil {
+		if err := c.updateClientCredential(cloudName, credentialName, cloudCredential); err != nil {
 			return err
 		}
 	}
@@ -286,9 +510,8 @@ func (c *credentialsClient) UpdateCredential(input UpdateCredentialInput) error
 	return nil
 }
 
-func getExistingClientCredential(cloudName string) (*jujucloud.CloudCredential, error) {
-	store := jujuclient.NewFileClientStore()
-	existingCredentials, err := store.CredentialForCloud(cloudName)
+func (c *credentialsClient) getExistingClientCredential(cloudName string) (*jujucloud.CloudCredential, error) {
+	existingCredentials, err := c.store.credentialForCloud(cloudName)
 	if err != nil && !errors.Is(err, errors.NotFound) {
 		return nil, errors.Annotate(err, "reading existing credentials for cloud")
 	}
@@ -298,15 +521,13 @@ func getExistingClientCredential(cloudName string) (*jujucloud.CloudCredential,
 	return existingCredentials, nil
 }
 
-func updateClientCredential(cloudName string, credentialName string, cloudCredential jujucloud.Credential) error {
-	existingCredentials, err := getExistingClientCredential(cloudName)
+func (c *credentialsClient) updateClientCredential(cloudName string, credentialName string, cloudCredential jujucloud.Credential) error {
+	err := c.store.update(cloudName, func(existingCredentials *jujucloud.CloudCredential) error {
+		// will overwrite if already exists
+		existingCredentials.AuthCredentials[credentialName] = cloudCredential
+		return nil
+	})
 	if err != nil {
-		return err
-	}
-	// will overwrite if already exists
-	existingCredentials.AuthCredentials[credentialName] = cloudCredential
-	store := jujuclient.NewFileClientStore()
-	if err := store.UpdateCredential(cloudName, *existingCredentials); err != nil {
 		return fmt.Errorf("credential %s not added for cloud %s: %s", credentialName, cloudName, err)
 	}
 	return nil
@@ -332,13 +553,13 @@ func (c *credentialsClient) DestroyCredential(input DestroyCredentialInput) erro
 	}
 
 	if input.ControllerCredential {
-		if err := client.RevokeCredential(*cloudCredTag, false); err != nil {
+		if err := revokeControllerCredential(client, *cloudCredTag, input.Force); err != nil {
 			return err
 		}
 	}
 
 	if input.ClientCredential {
-		if err := destroyClientCredential(cloudName, credentialName); err != nil {
+		if err := c.destroyClientCredential(cloudName, credentialName); err != nil {
 			return err
 		}
 	}
@@ -346,18 +567,143 @@ func (c *credentialsClient) DestroyCredential(input DestroyCredentialInput) erro
 	return nil
 }
 
-func destroyClientCredential(cloudName string, credentialName string) error {
-	existingCredentials, err := getExistingClientCredential(cloudName)
+// ReadControllerCredential reads a controller credential of the current
+// user, without its secret attributes.
+func (c *credentialsClient) ReadControllerCredential(input ReadControllerCredentialInput) (*ReadControllerCredentialResponse, error) {
+	conn, err := c.GetConnection(nil)
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	client := cloudapi.NewClient(conn)
+	defer client.Close()
+
+	results, err := client.CredentialContents(input.CloudName, input.Name, false)
+	if err != nil {
+		return nil, err
 	}
-	if _, ok := existingCredentials.AuthCredentials[credentialName]; !ok {
-		return fmt.Errorf("credential %s not found for cloud %s", credentialName, cloudName)
+	if results[0].Error != nil {
+		return nil, results[0].Error
 	}
-	delete(existingCredentials.AuthCredentials, credentialName)
-	store := jujuclient.NewFileClientStore()
-	if err := store.UpdateCredential(cloudName, *existingCredentials); err != nil {
-		return fmt.Errorf("credential %s not deleted for cloud %s: %s", credentialName, cloudName, err)
+
+	content := results[0].Result.Content
+	response := &ReadControllerCredentialResponse{
+		Name:       content.Name,
+		CloudName:  content.Cloud,
+		Owner:      strings.TrimPrefix(conn.AuthTag().String(), PrefixUser),
+		AuthType:   content.AuthType,
+		Valid:      content.Valid == nil || *content.Valid,
+		Attributes: content.Attributes,
 	}
-	return nil
+	for _, model := range results[0].Result.Models {
+		response.Models = append(response.Models, model.Model)
+	}
+	sort.Strings(response.Models)
+
+	return response, nil
+}
+
+// revokeControllerCredential revokes the controller credential. Unless
+// forced, a CredentialInUseError is returned if models use it.
+func revokeControllerCredential(client credentialRevokeAPI, tag names.CloudCredentialTag, force bool) error {
+	if !force {
+		models, err := credentialModels(client, tag.Cloud().Id(), tag.Name())
+		if err != nil {
+			return err
+		}
+		if len(models) > 0 {
+			return &CredentialInUseError{
+				CloudName: tag.Cloud().Id(),
+				Name:      tag.Name(),
+				Models:    models,
+			}
+		}
+	}
+	return client.RevokeCredential(tag, force)
+}
+
+// credentialModels returns the models using the controller credential.
+func credentialModels(client credentialRevokeAPI, cloudName, credentialName string) ([]string, error) {
+	results, err := client.CredentialContents(cloudName, credentialName, false)
+	if err != nil {
+		return nil, err
+	}
+
+	var models []string
+	for _, result := range results {
+		if result.Error != nil {
+			if params.IsCodeNotFound(result.Error) {
+				continue
+			}
+			return nil, result.Error
+		}
+		for _, model := range result.Result.Models {
+			models = append(models, model.Model)
+		}
+	}
+	sort.Strings(models)
+	return models, nil
+}
+
+// ReplaceModelCredential changes the models using a credential of the
+// current user to use the replacement credential instead, so the
+// credential can be revoked.
+func (c *credentialsClient) ReplaceModelCredential(input ReplaceModelCredentialInput) (*ReplaceModelCredentialResponse, error) {
+	conn, err := c.GetConnection(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	client := modelmanager.NewClient(conn)
+	defer client.Close()
+
+	currentUser := strings.TrimPrefix(conn.AuthTag().String(), PrefixUser)
+
+	return replaceModelCredential(client, currentUser, input)
+}
+
+// replaceModelCredential changes the credential of the models of the
+// user using the credential of the input to its replacement.
+func replaceModelCredential(client modelCredentialAPI, currentUser string, input ReplaceModelCredentialInput) (*ReplaceModelCredentialResponse, error) {
+	credentialTag, err := GetCloudCredentialTag(input.CloudName, currentUser, input.Name)
+	if err != nil {
+		return nil, err
+	}
+	replacementTag, err := GetCloudCredentialTag(input.CloudName, currentUser, input.Replacement)
+	if err != nil {
+		return nil, err
+	}
+
+	summaries, err := client.ListModelSummaries(currentUser, false)
+	if err != nil {
+		return nil, err
+	}
+
+	var models []string
+	for _, summary := range summaries {
+		if summary.CloudCredential != credentialTag.Id() {
+			continue
+		}
+		if err := client.ChangeModelCredential(names.NewModelTag(summary.UUID), *replacementTag); err != nil {
+			return nil, errors.Annotatef(err, "changing credential of model %s/%s", summary.Owner, summary.Name)
+		}
+		models = append(models, summary.Owner+"/"+summary.Name)
+	}
+	sort.Strings(models)
+
+	return &ReplaceModelCredentialResponse{Models: models}, nil
+}
+
+func (c *credentialsClient) destroyClientCredential(cloudName string, credentialName string) error {
+	return c.store.update(cloudName, func(existingCredentials *jujucloud.CloudCredential) error {
+		if _, ok := existingCredentials.AuthCredentials[credentialName]; !ok {
+			if c.store.writeOnly {
+				// added by a previous run, nothing to remove
+				return nil
+			}
+			return fmt.Errorf("credential %s not found for cloud %s", credentialName, cloudName)
+		}
+		delete(existingCredentials.AuthCredentials, credentialName)
+		return nil
+	})
 }
@@ -0,0 +1,218 @@
+package juju
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/juju/juju/api/base"
+	jujucloud "github.com/juju/juju/cloud"
+	"github.com/juju/juju/rpc/params"
+	"github.com/juju/names/v4"
+)
+
+// fakeCredentialRevokeAPI holds the models using each controller
+// credential, keyed by credential tag ID, and records the revocations.
+type fakeCredentialRevokeAPI struct {
+	models  map[string][]string
+	revoked map[string]bool
+}
+
+func (f *fakeCredentialRevokeAPI) CredentialContents(cloud, credential string, withSecrets bool) ([]params.CredentialContentResult, error) {
+	id := cloud + "/admin/" + credential
+	models, ok := f.models[id]
+	if !ok {
+		return []params.CredentialContentResult{{
+			Error: &params.Error{Message: "credential " + id + " not found", Code: params.CodeNotFound},
+		}}, nil
+	}
+	info := &params.ControllerCredentialInfo{
+		Content: params.CredentialContent{Name: credential, Cloud: cloud},
+	}
+	for _, model := range models {
+		info.Models = append(info.Models, params.ModelAccess{Model: model, Access: "admin"})
+	}
+	return []params.CredentialContentResult{{Result: info}}, nil
+}
+
+func (f *fakeCredentialRevokeAPI) RevokeCredential(tag names.CloudCredentialTag, force bool) error {
+	f.revoked[tag.Id()] = force
+	return nil
+}
+
+// fakeModelCredentialAPI holds the model summaries of the user and
+// records the credential changes, keyed by model UUID.
+type fakeModelCredentialAPI struct {
+	summaries []base.UserModelSummary
+	changed   map[string]string
+	err       error
+}
+
+func (f *fakeModelCredentialAPI) ListModelSummaries(user string, all bool) ([]base.UserModelSummary, error) {
+	return f.summaries, nil
+}
+
+func (f *fakeModelCredentialAPI) ChangeModelCredential(model names.ModelTag, credential names.CloudCredentialTag) error {
+	if f.err != nil {
+		return f.err
+	}
+	f.changed[model.Id()] = credential.Id()
+	return nil
+}
+
+func testCredentialSchema() jujucloud.CredentialSchema {
+	return jujucloud.CredentialSchema{{
+		Name: "client-email",
+	}, {
+		Name:           "private-key",
+		CredentialAttr: jujucloud.CredentialAttr{FileAttr: "private-key-file"},
+	}, {
+		Name:           "project-id",
+		CredentialAttr: jujucloud.CredentialAttr{Optional: true},
+	}}
+}
+
+func TestValidateCredentialAttributes(t *testing.T) {
+	tests := []struct {
+		attributes []string
+		err        string
+	}{
+		{attributes: []string{"client-email", "private-key"}},
+		{attributes: []string{"client-email", "private-key-file", "project-id"}},
+		{attributes: []string{"client-email"}, err: "missing attributes private-key"},
+		{attributes: []string{"client-email", "private-key", "token"}, err: "unknown attributes token"},
+		{attributes: []string{"token"}, err: "missing attributes client-email, private-key; unknown attributes token"},
+	}
+	for _, test := range tests {
+		err := validateCredentialAttributes(testCredentialSchema(), test.attributes)
+		if test.err == "" {
+			if err != nil {
+				t.Errorf("%v: unexpected error: %s", test.attributes, err)
+			}
+			continue
+		}
+		if err == nil || !strings.Contains(err.Error(), test.err) {
+			t.Errorf("%v: expected error %q, got %v", test.attributes, test.err, err)
+		}
+	}
+}
+
+func TestKnownCredentialSchemas(t *testing.T) {
+	schema := knownCredentialSchemas["lxd"][jujucloud.CertificateAuthType]
+	if err := validateCredentialAttributes(schema, []string{"client-cert", "client-key", "server-cert"}); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+	err := validateCredentialAttributes(schema, []string{"token"})
+	if err == nil || !strings.Contains(err.Error(), "missing attributes server-cert, client-cert, client-key; unknown attributes token") {
+		t.Errorf("expected missing and unknown attributes, got %v", err)
+	}
+
+	for cloudType, schemas := range knownCredentialSchemas {
+		for authType, schema := range schemas {
+			if len(schema) == 0 {
+				t.Errorf("%s: empty credential schema for auth-type %q", cloudType, authType)
+			}
+		}
+	}
+}
+
+func TestRevokeControllerCredential(t *testing.T) {
+	client := &fakeCredentialRevokeAPI{
+		models:  map[string][]string{"localhost/admin/unused": nil},
+		revoked: make(map[string]bool),
+	}
+
+	tag := names.NewCloudCredentialTag("localhost/admin/unused")
+	if err := revokeControllerCredential(client, tag, false); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if force, ok := client.revoked["localhost/admin/unused"]; !ok || force {
+		t.Errorf("expected the credential to be revoked without force, got %v", client.revoked)
+	}
+}
+
+func TestRevokeControllerCredentialInUse(t *testing.T) {
+	client := &fakeCredentialRevokeAPI{
+		models:  map[string][]string{"localhost/admin/used": {"test-b", "test-a"}},
+		revoked: make(map[string]bool),
+	}
+
+	err := revokeControllerCredential(client, names.NewCloudCredentialTag("localhost/admin/used"), false)
+	var inUseErr *CredentialInUseError
+	if !errors.As(err, &inUseErr) {
+		t.Fatalf("expected a CredentialInUseError, got %v", err)
+	}
+	if inUseErr.CloudName != "localhost" || inUseErr.Name != "used" || !reflect.DeepEqual(inUseErr.Models, []string{"test-a", "test-b"}) {
+		t.Errorf("unexpected error %+v", inUseErr)
+	}
+	if err.Error() != "credential used on cloud localhost is used by models test-a, test-b" {
+		t.Errorf("unexpected error message %q", err)
+	}
+	if len(client.revoked) != 0 {
+		t.Errorf("no credential should have been revoked, got %v", client.revoked)
+	}
+}
+
+func TestRevokeControllerCredentialForce(t *testing.T) {
+	client := &fakeCredentialRevokeAPI{
+		models:  map[string][]string{"localhost/admin/used": {"test-a"}},
+		revoked: make(map[string]bool),
+	}
+
+	if err := revokeControllerCredential(client, names.NewCloudCredentialTag("localhost/admin/used"), true); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if force, ok := client.revoked["localhost/admin/used"]; !ok || !force {
+		t.Errorf("expected the credential to be revoked with force, got %v", client.revoked)
+	}
+}
+
+func TestReplaceModelCredential(t *testing.T) {
+	client := &fakeModelCredentialAPI{
+		summaries: []base.UserModelSummary{
+			{Name: "test-b", UUID: "uuid-b", Owner: "admin", CloudCredential: "localhost/admin/old"},
+			{Name: "test-c", UUID: "uuid-c", Owner: "admin", CloudCredential: "localhost/admin/other"},
+			{Name: "test-a", UUID: "uuid-a", Owner: "admin", CloudCredential: "localhost/admin/old"},
+		},
+		changed: make(map[string]string),
+	}
+
+	response, err := replaceModelCredential(client, "admin", ReplaceModelCredentialInput{
+		CloudName:   "localhost",
+		Name:        "old",
+		Replacement: "new",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !reflect.DeepEqual(response.Models, []string{"admin/test-a", "admin/test-b"}) {
+		t.Errorf("unexpected models %v", response.Models)
+	}
+	expected := map[string]string{
+		"uuid-a": "localhost/admin/new",
+		"uuid-b": "localhost/admin/new",
+	}
+	if !reflect.DeepEqual(client.changed, expected) {
+		t.Errorf("unexpected credential changes %v", client.changed)
+	}
+}
+
+func TestReplaceModelCredentialError(t *testing.T) {
+	client := &fakeModelCredentialAPI{
+		summaries: []base.UserModelSummary{
+			{Name: "test-a", UUID: "uuid-a", Owner: "admin", CloudCredential: "localhost/admin/old"},
+		},
+		changed: make(map[string]string),
+		err:     errors.New("credential not valid for model"),
+	}
+
+	_, err := replaceModelCredential(client, "admin", ReplaceModelCredentialInput{
+		CloudName:   "localhost",
+		Name:        "old",
+		Replacement: "new",
+	})
+	if err == nil || !strings.Contains(err.Error(), "changing credential of model admin/test-a: credential not valid for model") {
+		t.Fatalf("expected the model to be reported, got %v", err)
+	}
+}
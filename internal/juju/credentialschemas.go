@@ -0,0 +1,100 @@
+package juju
+
+import (
+	jujucloud "github.com/juju/juju/cloud"
+)
+
+// knownCredentialSchemas are the credential schemas of the providers whose
+// packages are not built into the plugin, keyed by cloud type. They mirror
+// the CredentialSchemas of the providers in github.com/juju/juju/provider
+// and must be checked against them when the juju module is upgraded.
+//
+// The provider packages are not imported as they pull in the SDKs of
+// every cloud. The credential attributes of the clouds missing here,
+// e.g. azure, vsphere, oci or equinix, are not validated.
+var knownCredentialSchemas = map[string]map[jujucloud.AuthType]jujucloud.CredentialSchema{
+	"lxd": {
+		jujucloud.CertificateAuthType: {
+			{Name: "server-cert", CredentialAttr: jujucloud.CredentialAttr{
+				Description: "the PEM-encoded LXD server certificate",
+				Hidden:      true,
+			}},
+			{Name: "client-cert", CredentialAttr: jujucloud.CredentialAttr{
+				Description: "the PEM-encoded LXD client certificate",
+				Hidden:      true,
+			}},
+			{Name: "client-key", CredentialAttr: jujucloud.CredentialAttr{
+				Description: "the PEM-encoded LXD client key",
+				Hidden:      true,
+			}},
+		},
+		jujucloud.InteractiveAuthType: {
+			{Name: "trust-password", CredentialAttr: jujucloud.CredentialAttr{
+				Description: "the LXD server trust password",
+				Hidden:      true,
+			}},
+		},
+	},
+	"openstack": {
+		jujucloud.UserPassAuthType: {
+			{Name: "username", CredentialAttr: jujucloud.CredentialAttr{Description: "The username to authenticate with."}},
+			{Name: "password", CredentialAttr: jujucloud.CredentialAttr{
+				Description: "The password for the specified username.",
+				Hidden:      true,
+			}},
+			{Name: "tenant-name", CredentialAttr: jujucloud.CredentialAttr{Description: "The OpenStack tenant name.", Optional: true}},
+			{Name: "tenant-id", CredentialAttr: jujucloud.CredentialAttr{Description: "The Openstack tenant ID", Optional: true}},
+			{Name: "version", CredentialAttr: jujucloud.CredentialAttr{Description: "The Openstack identity version", Optional: true}},
+			{Name: "domain-name", CredentialAttr: jujucloud.CredentialAttr{Description: "The OpenStack domain name.", Optional: true}},
+			{Name: "project-domain-name", CredentialAttr: jujucloud.CredentialAttr{Description: "The OpenStack project domain name.", Optional: true}},
+			{Name: "user-domain-name", CredentialAttr: jujucloud.CredentialAttr{Description: "The OpenStack user domain name.", Optional: true}},
+		},
+		jujucloud.AccessKeyAuthType: {
+			{Name: "access-key", CredentialAttr: jujucloud.CredentialAttr{Description: "The access key to authenticate with."}},
+			{Name: "secret-key", CredentialAttr: jujucloud.CredentialAttr{
+				Description: "The secret key to authenticate with.",
+				Hidden:      true,
+			}},
+			{Name: "tenant-name", CredentialAttr: jujucloud.CredentialAttr{Description: "The OpenStack tenant name.", Optional: true}},
+			{Name: "tenant-id", CredentialAttr: jujucloud.CredentialAttr{Description: "The Openstack tenant ID", Optional: true}},
+			{Name: "version", CredentialAttr: jujucloud.CredentialAttr{Description: "The Openstack identity version", Optional: true}},
+		},
+	},
+	"maas": {
+		jujucloud.OAuth1AuthType: {
+			{Name: "maas-oauth", CredentialAttr: jujucloud.CredentialAttr{
+				Description: "OAuth/API-key credentials for MAAS",
+				Hidden:      true,
+			}},
+		},
+	},
+	"ec2": {
+		jujucloud.AccessKeyAuthType: {
+			{Name: "access-key", CredentialAttr: jujucloud.CredentialAttr{Description: "The EC2 access key"}},
+			{Name: "secret-key", CredentialAttr: jujucloud.CredentialAttr{
+				Description: "The EC2 secret key",
+				Hidden:      true,
+			}},
+		},
+		jujucloud.InstanceRoleAuthType: {
+			{Name: "instance-profile-name", CredentialAttr: jujucloud.CredentialAttr{Description: "The AWS Instance Profile name"}},
+		},
+	},
+	"gce": {
+		jujucloud.OAuth2AuthType: {
+			{Name: "client-id", CredentialAttr: jujucloud.CredentialAttr{Description: "client ID"}},
+			{Name: "client-email", CredentialAttr: jujucloud.CredentialAttr{Description: "client e-mail address"}},
+			{Name: "private-key", CredentialAttr: jujucloud.CredentialAttr{
+				Description: "client secret",
+				Hidden:      true,
+			}},
+			{Name: "project-id", CredentialAttr: jujucloud.CredentialAttr{Description: "project ID"}},
+		},
+		jujucloud.JSONFileAuthType: {
+			{Name: "file", CredentialAttr: jujucloud.CredentialAttr{
+				Description: "path to the .json file containing a service account key for your project",
+				FilePath:    true,
+			}},
+		},
+	},
+}
@@ -5,6 +5,7 @@ package juju
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
@@ -13,6 +14,7 @@ import (
 	apiapplication "github.com/juju/juju/api/client/application"
 	apiclient "github.com/juju/juju/api/client/client"
 	"github.com/juju/juju/rpc/params"
+	"github.com/juju/names/v4"
 )
 
 const (
@@ -22,6 +24,9 @@ const (
 	// IntegrationAppAvailableTimeout indicates the time to wait
 	// for applications to be available before integrating them
 	IntegrationAppAvailableTimeout = time.Second * 60
+	// IntegrationStatusTimeout indicates the time to wait for an
+	// integration to reach the expected status
+	IntegrationStatusTimeout = time.Minute * 10
 )
 
 type integrationsClient struct {
@@ -41,20 +46,28 @@ type Offer struct {
 
 type IntegrationInput struct {
 	ModelUUID string
-	Apps      []string
-	Endpoints []string
-	ViaCIDRs  string
+	// RelationID identifies the integration to read, when known. The
+	// endpoints are used to find the integration otherwise.
+	RelationID *int
+	Apps       []string
+	Endpoints  []string
+	ViaCIDRs   string
 }
 
 type CreateIntegrationResponse struct {
+	ID           int
 	Applications []Application
 }
 
 type ReadIntegrationResponse struct {
-	Applications []Application
+	ID            int
+	Applications  []Application
+	Status        string
+	StatusMessage string
 }
 
 type UpdateIntegrationResponse struct {
+	ID           int
 	Applications []Application
 }
 
@@ -66,6 +79,46 @@ type UpdateIntegrationInput struct {
 	ViaCIDRs     string
 }
 
+type WaitForIntegrationStatusInput struct {
+	ModelUUID  string
+	RelationID int
+	// Status lists the statuses the integration is expected to reach
+	Status []string
+}
+
+type SetIntegrationSuspendedInput struct {
+	ModelUUID  string
+	RelationID int
+	Suspended  bool
+	Reason     string
+}
+
+type ReadIntegrationDataInput struct {
+	ModelUUID  string
+	RelationID int
+}
+
+// ApplicationRelationData is the application data bag of an
+// application in an integration.
+type ApplicationRelationData struct {
+	Name     string
+	Endpoint string
+	Data     map[string]string
+}
+
+// UnitRelationData is the unit data bag of a unit in an integration.
+type UnitRelationData struct {
+	Name        string
+	Application string
+	InScope     bool
+	Data        map[string]string
+}
+
+type ReadIntegrationDataResponse struct {
+	Applications []ApplicationRelationData
+	Units        []UnitRelationData
+}
+
 func newIntegrationsClient(cf ConnectionFactory) *integrationsClient {
 	return &integrationsClient{
 		ConnectionFactory: cf,
@@ -85,7 +138,20 @@ func (c integrationsClient) CreateIntegration(input *IntegrationInput) (*CreateI
 	ctx, cancel := context.WithTimeout(context.Background(), IntegrationAppAvailableTimeout)
 	defer cancel()
 
-	err = WaitForAppsAvailable(ctx, client, input.Apps, IntegrationApiTickWait)
+	// SAAS applications are not reported by the application facade,
+	// they are available as soon as the offer is consumed
+	status, err := getStatus(conn)
+	if err != nil {
+		return nil, err
+	}
+	localApps := make([]string, 0, len(input.Apps))
+	for _, app := range input.Apps {
+		if _, remote := status.RemoteApplications[app]; !remote {
+			localApps = append(localApps, app)
+		}
+	}
+
+	err = WaitForAppsAvailable(ctx, client, localApps, IntegrationApiTickWait)
 	if err != nil {
 		return nil, errors.New("the applications were not available to be integrated")
 	}
@@ -100,7 +166,12 @@ func (c integrationsClient) CreateIntegration(input *IntegrationInput) (*CreateI
 	}
 
 	//integration is created - fetch the status in order to validate
-	status, err := getStatus(conn)
+	status, err = getStatus(conn)
+	if err != nil {
+		return nil, err
+	}
+
+	integration, err := findRelation(status.Relations, nil, charmRelationEndpoints(response.Endpoints))
 	if err != nil {
 		return nil, err
 	}
@@ -108,6 +179,7 @@ func (c integrationsClient) CreateIntegration(input *IntegrationInput) (*CreateI
 	applications := parseApplications(status.RemoteApplications, response.Endpoints)
 
 	return &CreateIntegrationResponse{
+		ID:           integration.Id,
 		Applications: applications,
 	}, nil
 }
@@ -126,48 +198,18 @@ func (c integrationsClient) ReadIntegration(input *IntegrationInput) (*ReadInteg
 		return nil, err
 	}
 
-	integrations := status.Relations
-	var integration params.RelationStatus
-	if len(integrations) == 0 {
-		return nil, fmt.Errorf("no integrations exist in specified model")
-	}
-
-	apps := make([][]string, 0, len(input.Endpoints))
-	for _, v := range input.Endpoints {
-		app := strings.Split(v, ":")
-		apps = append(apps, []string{
-			app[0],
-			app[1],
-		})
-	}
-	// the key is built assuming that the ID is "<provider>:<endpoint> <requirer>:<endpoint>"
-	// the integrations that come back from status have the key formatted as "<requirer>:<endpoint> <provider>:<endpoint>"
-	key := fmt.Sprintf("%v:%v %v:%v", apps[1][0], apps[1][1], apps[0][0], apps[0][1])
-
-	for _, v := range integrations {
-		if v.Key == key {
-			integration = v
-			break
-		}
-	}
-
-	if integration.Id == 0 && integration.Key == "" {
-		keyReversed := fmt.Sprintf("%v:%v %v:%v", apps[1][0], apps[1][1], apps[0][0], apps[0][1])
-		for _, v := range integrations {
-			if v.Key == keyReversed {
-				return nil, fmt.Errorf("check the endpoint order in your ID")
-			}
-		}
-	}
-
-	if integration.Id == 0 && integration.Key == "" {
-		return nil, fmt.Errorf("integration not found in model")
+	integration, err := findRelation(status.Relations, input.RelationID, input.Endpoints)
+	if err != nil {
+		return nil, err
 	}
 
 	applications := parseApplications(status.RemoteApplications, integration.Endpoints)
 
 	return &ReadIntegrationResponse{
-		Applications: applications,
+		ID:            integration.Id,
+		Applications:  applications,
+		Status:        integration.Status.Status,
+		StatusMessage: integration.Status.Info,
 	}, nil
 }
 
@@ -214,9 +256,15 @@ func (c integrationsClient) UpdateIntegration(input *UpdateIntegrationInput) (*U
 		return nil, err
 	}
 
+	integration, err := findRelation(status.Relations, nil, charmRelationEndpoints(response.Endpoints))
+	if err != nil {
+		return nil, err
+	}
+
 	applications := parseApplications(status.RemoteApplications, response.Endpoints)
 
 	return &UpdateIntegrationResponse{
+		ID:           integration.Id,
 		Applications: applications,
 	}, nil
 }
@@ -245,11 +293,317 @@ func (c integrationsClient) DestroyIntegration(input *IntegrationInput) error {
 	return nil
 }
 
-func getStatus(conn api.Connection) (*params.FullStatus, error) {
+// ReadIntegrationData returns the application and unit data bags of an
+// integration, as seen by the units of the local applications. The data
+// bags of remote applications and units are only available from the
+// units they are integrated with.
+func (c integrationsClient) ReadIntegrationData(input *ReadIntegrationDataInput) (*ReadIntegrationDataResponse, error) {
+	conn, err := c.GetConnection(&input.ModelUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	client := apiapplication.NewClient(conn)
+	defer client.Close()
+
+	status, err := getStatus(conn)
+	if err != nil {
+		return nil, err
+	}
+
+	var relation *params.RelationStatus
+	for i := range status.Relations {
+		if status.Relations[i].Id == input.RelationID {
+			relation = &status.Relations[i]
+			break
+		}
+	}
+	if relation == nil {
+		return nil, fmt.Errorf("integration %d not found in model", input.RelationID)
+	}
+
+	applications := make(map[string]bool)
+	for _, endpoint := range relation.Endpoints {
+		applications[endpoint.ApplicationName] = true
+	}
+	tags := []names.UnitTag{}
+	for _, unit := range statusUnits(status.Applications) {
+		if applications[unitApplication(unit)] {
+			tags = append(tags, names.NewUnitTag(unit))
+		}
+	}
+	if len(tags) == 0 {
+		return &ReadIntegrationDataResponse{
+			Applications: []ApplicationRelationData{},
+			Units:        []UnitRelationData{},
+		}, nil
+	}
+
+	units, err := client.UnitsInfo(tags)
+	if err != nil {
+		return nil, err
+	}
+
+	return mergeRelationData(*relation, units)
+}
+
+// statusUnits returns the names of all the units in the status,
+// including the subordinate units.
+func statusUnits(applications map[string]params.ApplicationStatus) []string {
+	units := []string{}
+	var collect func(map[string]params.UnitStatus)
+	collect = func(statuses map[string]params.UnitStatus) {
+		for name, unit := range statuses {
+			units = append(units, name)
+			collect(unit.Subordinates)
+		}
+	}
+	for _, application := range applications {
+		collect(application.Units)
+	}
+	sort.Strings(units)
+	return units
+}
+
+// mergeRelationData merges the data bags of the relation seen by each
+// unit. A unit sees the application data bag of the related application
+// and the unit data bags of the related units.
+func mergeRelationData(relation params.RelationStatus, units []apiapplication.UnitInfo) (*ReadIntegrationDataResponse, error) {
+	applicationData := make(map[string]ApplicationRelationData)
+	unitData := make(map[string]UnitRelationData)
+
+	for _, unit := range units {
+		if unit.Error != nil {
+			return nil, unit.Error
+		}
+		tag, err := names.ParseUnitTag(unit.Tag)
+		if err != nil {
+			return nil, err
+		}
+		application := unitApplication(tag.Id())
+
+		for _, data := range unit.RelationData {
+			if data.RelationId != relation.Id {
+				continue
+			}
+
+			related := relatedEndpoint(relation, application, data.Endpoint, data.RelatedEndpoint)
+			if related != nil {
+				applicationData[related.ApplicationName] = ApplicationRelationData{
+					Name:     related.ApplicationName,
+					Endpoint: related.Name,
+					Data:     relationDataToStrings(data.ApplicationData),
+				}
+			}
+			for name, relatedUnit := range data.UnitRelationData {
+				unitData[name] = UnitRelationData{
+					Name:        name,
+					Application: unitApplication(name),
+					InScope:     relatedUnit.InScope,
+					Data:        relationDataToStrings(relatedUnit.UnitData),
+				}
+			}
+		}
+	}
+
+	response := &ReadIntegrationDataResponse{
+		Applications: make([]ApplicationRelationData, 0, len(applicationData)),
+		Units:        make([]UnitRelationData, 0, len(unitData)),
+	}
+	for _, data := range applicationData {
+		response.Applications = append(response.Applications, data)
+	}
+	for _, data := range unitData {
+		response.Units = append(response.Units, data)
+	}
+	sort.Slice(response.Applications, func(i, j int) bool {
+		return response.Applications[i].Name < response.Applications[j].Name
+	})
+	sort.Slice(response.Units, func(i, j int) bool {
+		return response.Units[i].Name < response.Units[j].Name
+	})
+
+	return response, nil
+}
+
+// relatedEndpoint returns the endpoint of the relation on the other side
+// of the given application endpoint. Peer relations relate an
+// application with itself.
+func relatedEndpoint(relation params.RelationStatus, application, endpoint, related string) *params.EndpointStatus {
+	if len(relation.Endpoints) == 1 {
+		return &relation.Endpoints[0]
+	}
+	for i, candidate := range relation.Endpoints {
+		if candidate.ApplicationName == application && candidate.Name == endpoint {
+			continue
+		}
+		if candidate.Name == related {
+			return &relation.Endpoints[i]
+		}
+	}
+	return nil
+}
+
+// unitApplication returns the application of a unit name.
+func unitApplication(unit string) string {
+	return strings.Split(unit, "/")[0]
+}
+
+func relationDataToStrings(data map[string]interface{}) map[string]string {
+	values := make(map[string]string, len(data))
+	for key, value := range data {
+		values[key] = fmt.Sprintf("%v", value)
+	}
+	return values
+}
+
+// WaitForIntegrationStatus polls the status of the model until the
+// integration reaches one of the input statuses, for at most
+// IntegrationStatusTimeout.
+func (c integrationsClient) WaitForIntegrationStatus(input *WaitForIntegrationStatusInput) error {
+	conn, err := c.GetConnection(&input.ModelUUID)
+	if err != nil {
+		return err
+	}
+
+	client := apiclient.NewClient(conn)
+	defer client.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), IntegrationStatusTimeout)
+	defer cancel()
+
+	return waitForRelationStatus(ctx, client, input.RelationID, input.Status, IntegrationApiTickWait)
+}
+
+// SetIntegrationSuspended suspends or resumes a cross model integration.
+// A suspended integration keeps existing but the consumer can not use
+// it until it is resumed.
+func (c integrationsClient) SetIntegrationSuspended(input *SetIntegrationSuspendedInput) error {
+	conn, err := c.GetConnection(&input.ModelUUID)
+	if err != nil {
+		return err
+	}
+
+	client := apiapplication.NewClient(conn)
+	defer client.Close()
+
+	return client.SetRelationSuspended([]int{input.RelationID}, input.Suspended, input.Reason)
+}
+
+// statusAPI is the part of the Client facade used to follow the status
+// of an integration.
+type statusAPI interface {
+	Status(patterns []string) (*params.FullStatus, error)
+}
+
+func waitForRelationStatus(ctx context.Context, client statusAPI, relationID int, expected []string, tickTime time.Duration) error {
+	tick := time.NewTicker(tickTime)
+	defer tick.Stop()
+
+	lastStatus := ""
+	for {
+		select {
+		case <-tick.C:
+			status, err := client.Status(nil)
+			if err != nil {
+				return err
+			}
+			var relation *params.RelationStatus
+			for i := range status.Relations {
+				if status.Relations[i].Id == relationID {
+					relation = &status.Relations[i]
+					break
+				}
+			}
+			if relation == nil {
+				return fmt.Errorf("integration %d not found in model", relationID)
+			}
+			lastStatus = relation.Status.Status
+			for _, s := range expected {
+				if lastStatus == s {
+					return nil
+				}
+			}
+			if lastStatus == "error" || lastStatus == "broken" {
+				return fmt.Errorf("integration %d is %s: %s", relationID, lastStatus, relation.Status.Info)
+			}
+		case <-ctx.Done():
+			return fmt.Errorf("integration %d did not reach status %s, last status was %q", relationID, strings.Join(expected, " or "), lastStatus)
+		}
+	}
+}
+
+// findRelation returns the relation with the given id, or when the id
+// is unknown or no longer exists, the relation between the given
+// endpoints in any order. Endpoints are "<application>:<endpoint>", or
+// just "<application>" to match any endpoint of the application.
+func findRelation(relations []params.RelationStatus, id *int, endpoints []string) (*params.RelationStatus, error) {
+	if len(relations) == 0 {
+		return nil, fmt.Errorf("no integrations exist in specified model")
+	}
+
+	if id != nil {
+		for i, relation := range relations {
+			if relation.Id == *id && relationHasEndpoints(relation, endpoints) {
+				return &relations[i], nil
+			}
+		}
+	}
+
+	for i, relation := range relations {
+		if relationHasEndpoints(relation, endpoints) {
+			return &relations[i], nil
+		}
+	}
+
+	return nil, fmt.Errorf("integration not found in model")
+}
+
+func relationHasEndpoints(relation params.RelationStatus, endpoints []string) bool {
+	if len(relation.Endpoints) != len(endpoints) {
+		return false
+	}
+
+	matched := make([]bool, len(relation.Endpoints))
+	for _, endpoint := range endpoints {
+		application, name, _ := strings.Cut(endpoint, ":")
+		found := false
+		for i, relationEndpoint := range relation.Endpoints {
+			if matched[i] || relationEndpoint.ApplicationName != application {
+				continue
+			}
+			if name != "" && relationEndpoint.Name != name {
+				continue
+			}
+			matched[i] = true
+			found = true
+			break
+		}
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+// charmRelationEndpoints returns the endpoints of a relation added to
+// the model in the "<application>:<endpoint>" format.
+func charmRelationEndpoints(relations map[string]params.CharmRelation) []string {
+	endpoints := make([]string, 0, len(relations))
+	for application, relation := range relations {
+		endpoints = append(endpoints, fmt.Sprintf("%s:%s", application, relation.Name))
+	}
+	return endpoints
+}
+
+// getStatus returns the status of the model of the connection. The
+// optional patterns filter the status the same way as `juju status`.
+func getStatus(conn api.Connection, patterns ...string) (*params.FullStatus, error) {
 	client := apiclient.NewClient(conn)
 	defer client.Close()
 
-	status, err := client.Status(nil)
+	status, err := client.Status(patterns)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,204 @@
+package juju
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	apiapplication "github.com/juju/juju/api/client/application"
+	"github.com/juju/juju/rpc/params"
+)
+
+func testRelations() []params.RelationStatus {
+	return []params.RelationStatus{{
+		Id:  3,
+		Key: "wordpress:db mysql:server",
+		Endpoints: []params.EndpointStatus{
+			{ApplicationName: "wordpress", Name: "db", Role: "requirer"},
+			{ApplicationName: "mysql", Name: "server", Role: "provider"},
+		},
+	}, {
+		Id:  7,
+		Key: "wordpress:cache memcached:cache",
+		Endpoints: []params.EndpointStatus{
+			{ApplicationName: "wordpress", Name: "cache", Role: "requirer"},
+			{ApplicationName: "memcached", Name: "cache", Role: "provider"},
+		},
+	}, {
+		Id:  9,
+		Key: "mysql:cluster",
+		Endpoints: []params.EndpointStatus{
+			{ApplicationName: "mysql", Name: "cluster", Role: "peer"},
+		},
+	}}
+}
+
+func TestFindRelation(t *testing.T) {
+	id := func(i int) *int { return &i }
+
+	tests := []struct {
+		about     string
+		id        *int
+		endpoints []string
+		expected  int
+	}{{
+		about:     "endpoints in status order",
+		endpoints: []string{"wordpress:db", "mysql:server"},
+		expected:  3,
+	}, {
+		about:     "endpoints in reversed order",
+		endpoints: []string{"mysql:server", "wordpress:db"},
+		expected:  3,
+	}, {
+		about:     "application names only",
+		endpoints: []string{"memcached", "wordpress"},
+		expected:  7,
+	}, {
+		about:     "relation id",
+		id:        id(7),
+		endpoints: []string{"memcached:cache", "wordpress:cache"},
+		expected:  7,
+	}, {
+		about:     "relation id replaced by a new relation between the endpoints",
+		id:        id(1),
+		endpoints: []string{"mysql:server", "wordpress:db"},
+		expected:  3,
+	}}
+
+	for _, test := range tests {
+		relation, err := findRelation(testRelations(), test.id, test.endpoints)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", test.about, err)
+			continue
+		}
+		if relation.Id != test.expected {
+			t.Errorf("%s: expected relation %d, got %d", test.about, test.expected, relation.Id)
+		}
+	}
+}
+
+func TestFindRelationNotFound(t *testing.T) {
+	_, err := findRelation(testRelations(), nil, []string{"wordpress:db", "mysql:cluster"})
+	if err == nil || err.Error() != "integration not found in model" {
+		t.Errorf("expected integration not found error, got %v", err)
+	}
+
+	_, err = findRelation(nil, nil, []string{"wordpress:db", "mysql:server"})
+	if err == nil || err.Error() != "no integrations exist in specified model" {
+		t.Errorf("expected no integrations error, got %v", err)
+	}
+}
+
+type fakeStatusAPI struct {
+	statuses []string
+	calls    int
+}
+
+func (f *fakeStatusAPI) Status(patterns []string) (*params.FullStatus, error) {
+	status := f.statuses[len(f.statuses)-1]
+	if f.calls < len(f.statuses) {
+		status = f.statuses[f.calls]
+	}
+	f.calls++
+	return &params.FullStatus{
+		Relations: []params.RelationStatus{{
+			Id:     3,
+			Status: params.DetailedStatus{Status: status, Info: "hook failed"},
+		}},
+	}, nil
+}
+
+func TestWaitForRelationStatus(t *testing.T) {
+	client := &fakeStatusAPI{statuses: []string{"joining", "joining", "joined"}}
+
+	err := waitForRelationStatus(context.Background(), client, 3, []string{"joined"}, time.Millisecond)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if client.calls != 3 {
+		t.Errorf("expected 3 status calls, got %d", client.calls)
+	}
+}
+
+func TestWaitForRelationStatusError(t *testing.T) {
+	client := &fakeStatusAPI{statuses: []string{"joining", "error"}}
+
+	err := waitForRelationStatus(context.Background(), client, 3, []string{"joined"}, time.Millisecond)
+	if err == nil || !strings.Contains(err.Error(), "integration 3 is error: hook failed") {
+		t.Errorf("expected an integration error, got %v", err)
+	}
+}
+
+func TestWaitForRelationStatusTimeout(t *testing.T) {
+	client := &fakeStatusAPI{statuses: []string{"suspending"}}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	err := waitForRelationStatus(ctx, client, 3, []string{"suspended"}, time.Millisecond)
+	if err == nil || !strings.Contains(err.Error(), `last status was "suspending"`) {
+		t.Errorf("expected a timeout error, got %v", err)
+	}
+}
+
+func TestWaitForRelationStatusNotFound(t *testing.T) {
+	client := &fakeStatusAPI{statuses: []string{"joined"}}
+
+	err := waitForRelationStatus(context.Background(), client, 4, []string{"joined"}, time.Millisecond)
+	if err == nil || err.Error() != "integration 4 not found in model" {
+		t.Errorf("expected integration not found error, got %v", err)
+	}
+}
+
+func TestMergeRelationData(t *testing.T) {
+	relation := testRelations()[0]
+	units := []apiapplication.UnitInfo{{
+		Tag: "unit-wordpress-0",
+		RelationData: []apiapplication.EndpointRelationData{{
+			RelationId:      3,
+			Endpoint:        "db",
+			RelatedEndpoint: "server",
+			ApplicationData: map[string]interface{}{"database": "wordpress"},
+			UnitRelationData: map[string]apiapplication.RelationData{
+				"mysql/0": {InScope: true, UnitData: map[string]interface{}{"host": "10.0.0.2", "port": 3306}},
+			},
+		}, {
+			RelationId:      7,
+			Endpoint:        "cache",
+			RelatedEndpoint: "cache",
+			ApplicationData: map[string]interface{}{"ignored": "true"},
+		}},
+	}, {
+		Tag: "unit-mysql-0",
+		RelationData: []apiapplication.EndpointRelationData{{
+			RelationId:      3,
+			Endpoint:        "server",
+			RelatedEndpoint: "db",
+			ApplicationData: map[string]interface{}{},
+			UnitRelationData: map[string]apiapplication.RelationData{
+				"wordpress/0": {InScope: true, UnitData: map[string]interface{}{"private-address": "10.0.0.1"}},
+			},
+		}},
+	}}
+
+	response, err := mergeRelationData(relation, units)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := &ReadIntegrationDataResponse{
+		Applications: []ApplicationRelationData{
+			{Name: "mysql", Endpoint: "server", Data: map[string]string{"database": "wordpress"}},
+			{Name: "wordpress", Endpoint: "db", Data: map[string]string{}},
+		},
+		Units: []UnitRelationData{
+			{Name: "mysql/0", Application: "mysql", InScope: true, Data: map[string]string{"host": "10.0.0.2", "port": "3306"}},
+			{Name: "wordpress/0", Application: "wordpress", InScope: true, Data: map[string]string{"private-address": "10.0.0.1"}},
+		},
+	}
+	if !reflect.DeepEqual(response, expected) {
+		t.Errorf("expected %+v, got %+v", expected, response)
+	}
+}
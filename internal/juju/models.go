@@ -1,13 +1,18 @@
 package juju
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"sort"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/juju/juju/api"
+	apicontroller "github.com/juju/juju/api/controller/controller"
 	"github.com/juju/juju/core/constraints"
+	"github.com/juju/juju/core/life"
 	"github.com/pkg/errors"
 
 	"github.com/juju/juju/api/client/modelconfig"
@@ -18,8 +23,94 @@ import (
 	"github.com/juju/names/v4"
 )
 
+const (
+	// ModelMigrationTimeout is the time to wait for a model migration
+	// to complete before giving up
+	ModelMigrationTimeout = 30 * time.Minute
+	// ModelMigrationTickWait is the time to wait between consecutive
+	// requests for the migration status
+	ModelMigrationTickWait = 10 * time.Second
+)
+
 type modelsClient struct {
 	ConnectionFactory
+	cache *modelUUIDCache
+}
+
+// modelUUIDCache keeps the UUIDs of the models resolved by name. The
+// provider is started for every Terraform operation, so entries live
+// for a single plan or apply.
+type modelUUIDCache struct {
+	mu    sync.Mutex
+	uuids map[string]string
+}
+
+func (c *modelUUIDCache) get(model string) (string, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	uuid, ok := c.uuids[model]
+	return uuid, ok
+}
+
+func (c *modelUUIDCache) set(model, uuid string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.uuids[model] = uuid
+}
+
+// forget removes every entry pointing to the given model UUID.
+func (c *modelUUIDCache) forget(uuid string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for model, cached := range c.uuids {
+		if cached == uuid {
+			delete(c.uuids, model)
+		}
+	}
+}
+
+// ModelNotFoundError is returned when a model reference does not match
+// any model visible to the user. Candidates contains the qualified names
+// of similar models.
+type ModelNotFoundError struct {
+	Model      string
+	Candidates []string
+}
+
+func (e *ModelNotFoundError) Error() string {
+	if len(e.Candidates) == 0 {
+		return fmt.Sprintf("model %q not found", e.Model)
+	}
+	return fmt.Sprintf("model %q not found, did you mean one of: %s", e.Model, strings.Join(e.Candidates, ", "))
+}
+
+// AmbiguousModelError is returned when an unqualified model name matches
+// models of several owners, none of them the current user.
+type AmbiguousModelError struct {
+	Model      string
+	Candidates []string
+}
+
+func (e *AmbiguousModelError) Error() string {
+	return fmt.Sprintf("model name %q is ambiguous, qualify it with its owner as one of: %s", e.Model, strings.Join(e.Candidates, ", "))
+}
+
+// migrationControllerAPI is the part of the Controller facade used to
+// start a model migration.
+type migrationControllerAPI interface {
+	InitiateMigration(spec apicontroller.MigrationSpec) (string, error)
+}
+
+// controllerTagAPI is the part of an API connection identifying the
+// controller it is connected to.
+type controllerTagAPI interface {
+	ControllerTag() names.ControllerTag
+}
+
+// modelInfoAPI is the part of the ModelManager facade used to follow
+// a model migration.
+type modelInfoAPI interface {
+	ModelInfo(tags []names.ModelTag) ([]params.ModelInfoResult, error)
 }
 
 type GrantModelInput struct {
@@ -60,10 +151,71 @@ type UpdateModelInput struct {
 }
 
 type UpdateAccessModelInput struct {
-	Model  string
-	Grant  []string
-	Revoke []string
-	Access string
+	ModelUUID string
+	Grant     []string
+	Revoke    []string
+	Access    string
+}
+
+type ReadAccessModelInput struct {
+	ModelUUID string
+}
+
+type ReadAccessModelResponse struct {
+	// Owner is the owner of the model, whose access is never changed
+	Owner string
+	// Users maps the users to their model access
+	Users map[string]string
+}
+
+// SetAccessModelInput sets the access of the users to exactly Access.
+// The other users with this access are removed from the model.
+type SetAccessModelInput struct {
+	ModelUUID string
+	Users     []string
+	Access    string
+}
+
+// MigrateModelInput describes the migration of a model from the
+// controller of the client to the Target controller.
+type MigrateModelInput struct {
+	UUID   string
+	Target *modelsClient
+}
+
+type ReadModelDefaultsInput struct {
+	Cloud  string
+	Region string
+}
+
+type ReadModelDefaultsResponse struct {
+	Config map[string]interface{}
+}
+
+type UpdateModelDefaultsInput struct {
+	Cloud  string
+	Region string
+	Config map[string]interface{}
+	Unset  []string
+}
+
+type ListModelsInput struct {
+	Owner string
+	Cloud string
+	Type  string
+}
+
+type ListModelsResponse struct {
+	Models []base.UserModelSummary
+}
+
+type ReadModelStatusInput struct {
+	ModelUUID string
+	Patterns  []string
+}
+
+type ReadModelStatusResponse struct {
+	Status params.FullStatus
 }
 
 type DestroyModelInput struct {
@@ -71,42 +223,121 @@ type DestroyModelInput struct {
 }
 
 type DestroyAccessModelInput struct {
-	Model  string
-	Revoke []string
-	Access string
+	ModelUUID string
+	Revoke    []string
 }
 
 func newModelsClient(cf ConnectionFactory) *modelsClient {
 	return &modelsClient{
 		ConnectionFactory: cf,
+		cache: &modelUUIDCache{
+			uuids: make(map[string]string),
+		},
+	}
+}
+
+// ForController returns a models client connecting to the controller
+// described by config. A nil config returns the current client.
+func (c *modelsClient) ForController(config *Configuration) *modelsClient {
+	if config == nil {
+		return c
 	}
+	return newModelsClient(ConnectionFactory{
+		config: *config,
+	})
 }
 
 func (c *modelsClient) getCurrentUser(conn api.Connection) string {
 	return strings.TrimPrefix(conn.AuthTag().String(), PrefixUser)
 }
 
+// splitModelReference splits a model reference in the `owner/name` or
+// `name` format into its owner, if any, and name.
+func splitModelReference(model string) (owner string, name string) {
+	if i := strings.Index(model, "/"); i != -1 {
+		return model[:i], model[i+1:]
+	}
+	return "", model
+}
+
+func qualifiedModelName(summary base.UserModelSummary) string {
+	return fmt.Sprintf("%s/%s", summary.Owner, summary.Name)
+}
+
+// matchModelSummary finds the model referenced as `owner/name` or `name`.
+// An unqualified name shared by several owners resolves to the model of
+// the current user.
+func matchModelSummary(summaries []base.UserModelSummary, model string, user string) (*base.UserModelSummary, error) {
+	owner, name := splitModelReference(model)
+
+	var matches []base.UserModelSummary
+	for _, summary := range summaries {
+		if summary.Name == name && (owner == "" || summary.Owner == owner) {
+			matches = append(matches, summary)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, &ModelNotFoundError{
+			Model:      model,
+			Candidates: modelCandidates(summaries, name),
+		}
+	case 1:
+		return &matches[0], nil
+	}
+
+	candidates := make([]string, 0, len(matches))
+	for i, match := range matches {
+		if match.Owner == user {
+			return &matches[i], nil
+		}
+		candidates = append(candidates, qualifiedModelName(match))
+	}
+	sort.Strings(candidates)
+	return nil, &AmbiguousModelError{
+		Model:      model,
+		Candidates: candidates,
+	}
+}
+
+// modelCandidates returns the qualified names of the models whose name
+// looks like the given one.
+func modelCandidates(summaries []base.UserModelSummary, name string) []string {
+	name = strings.ToLower(name)
+	var candidates []string
+	for _, summary := range summaries {
+		candidate := strings.ToLower(summary.Name)
+		if strings.Contains(candidate, name) || strings.Contains(name, candidate) {
+			candidates = append(candidates, qualifiedModelName(summary))
+		}
+	}
+	sort.Strings(candidates)
+	return candidates
+}
+
 func (c *modelsClient) resolveModelUUIDWithClient(client modelmanager.Client, name string, user string) (string, error) {
-	modelUUID := ""
+	if uuid, ok := c.cache.get(name); ok {
+		return uuid, nil
+	}
+
 	modelSummaries, err := client.ListModelSummaries(user, false)
 	if err != nil {
 		return "", err
 	}
-	for _, modelSummary := range modelSummaries {
-		if modelSummary.Name == name {
-			modelUUID = modelSummary.UUID
-			break
-		}
-	}
 
-	if modelUUID == "" {
-		return "", fmt.Errorf("model not found for defined user")
+	modelSummary, err := matchModelSummary(modelSummaries, name, user)
+	if err != nil {
+		return "", err
 	}
 
-	return modelUUID, nil
+	c.cache.set(name, modelSummary.UUID)
+
+	return modelSummary.UUID, nil
 }
 
-// GetModelByName retrieves a model by name
+// GetModelByName retrieves a model by its name, optionally qualified
+// with its owner as `owner/name`
 func (c *modelsClient) GetModelByName(name string) (*params.ModelInfo, error) {
 	conn, err := c.GetConnection(nil)
 	if err != nil {
@@ -141,23 +372,62 @@ func (c *modelsClient) GetModelByName(name string) (*params.ModelInfo, error) {
 	return modelInfo, nil
 }
 
-// ResolveModelUUID retrieves a model's using its name
-func (c *modelsClient) ResolveModelUUID(name string) (string, error) {
+// ListModels returns the summaries of the models visible to the
+// current user matching the input filters. Empty filters match every
+// model.
+func (c *modelsClient) ListModels(input ListModelsInput) (*ListModelsResponse, error) {
 	conn, err := c.GetConnection(nil)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
 	currentUser := c.getCurrentUser(conn)
 	client := modelmanager.NewClient(conn)
 	defer client.Close()
 
-	modelUUID, err := c.resolveModelUUIDWithClient(*client, name, currentUser)
+	modelSummaries, err := client.ListModelSummaries(currentUser, false)
 	if err != nil {
-		return "", nil
+		return nil, err
 	}
 
-	return modelUUID, nil
+	return &ListModelsResponse{
+		Models: filterModelSummaries(modelSummaries, input),
+	}, nil
+}
+
+func filterModelSummaries(summaries []base.UserModelSummary, input ListModelsInput) []base.UserModelSummary {
+	models := []base.UserModelSummary{}
+	for _, summary := range summaries {
+		if input.Owner != "" && summary.Owner != input.Owner {
+			continue
+		}
+		if input.Cloud != "" && summary.Cloud != input.Cloud {
+			continue
+		}
+		if input.Type != "" && summary.Type.String() != input.Type {
+			continue
+		}
+		models = append(models, summary)
+	}
+	sort.Slice(models, func(i, j int) bool {
+		return qualifiedModelName(models[i]) < qualifiedModelName(models[j])
+	})
+	return models
+}
+
+// ResolveModelUUID retrieves a model's UUID using its name, optionally
+// qualified with its owner as `owner/name`
+func (c *modelsClient) ResolveModelUUID(name string) (string, error) {
+	conn, err := c.GetConnection(nil)
+	if err != nil {
+		return "", err
+	}
+
+	currentUser := c.getCurrentUser(conn)
+	client := modelmanager.NewClient(conn)
+	defer client.Close()
+
+	return c.resolveModelUUIDWithClient(*client, name, currentUser)
 }
 
 func (c *modelsClient) CreateModel(input CreateModelInput) (*CreateModelResponse, error) {
@@ -267,6 +537,24 @@ func (c *modelsClient) ReadModel(uuid string) (*ReadModelResponse, error) {
 	}, nil
 }
 
+// ReadModelStatus returns the full status of a model, filtered by the
+// input patterns as `juju status` does.
+func (c *modelsClient) ReadModelStatus(input ReadModelStatusInput) (*ReadModelStatusResponse, error) {
+	conn, err := c.GetConnection(&input.ModelUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	status, err := getStatus(conn, input.Patterns...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ReadModelStatusResponse{
+		Status: *status,
+	}, nil
+}
+
 func (c *modelsClient) UpdateModel(input UpdateModelInput) error {
 	conn, err := c.GetConnection(&input.UUID)
 	if err != nil {
@@ -346,39 +634,222 @@ func (c *modelsClient) DestroyModel(input DestroyModelInput) error {
 		return err
 	}
 
+	// a model with the same name may be created during the same apply
+	c.cache.forget(input.UUID)
+
 	return nil
 }
 
-func (c *modelsClient) GrantModel(input GrantModelInput) error {
+// MigrateModel moves a model to the target controller. It blocks until
+// the model is available on the target or the migration is aborted. No
+// migration happens if the target is the controller hosting the model.
+func (c *modelsClient) MigrateModel(input MigrateModelInput) error {
+	if input.Target == nil {
+		return fmt.Errorf("no target controller provided to migrate model %s", input.UUID)
+	}
+
 	conn, err := c.GetConnection(nil)
 	if err != nil {
 		return err
 	}
+	defer conn.Close()
+
+	targetConn, err := input.Target.GetConnection(nil)
+	if err != nil {
+		return err
+	}
+	defer targetConn.Close()
+
+	spec := modelMigrationSpec(input.UUID, conn, targetConn, input.Target.config)
+	if spec == nil {
+		// only the settings used to reach the controller changed
+		log.Printf("[DEBUG] Model %s is already hosted on controller %s", input.UUID, targetConn.ControllerTag().Id())
+		return nil
+	}
+
+	controllerClient := apicontroller.NewClient(conn)
+	defer controllerClient.Close()
+
+	migrationID, err := initiateModelMigration(controllerClient, *spec)
+	if err != nil {
+		return err
+	}
+	log.Printf("[DEBUG] Started migration %s of model %s", migrationID, input.UUID)
+
+	sourceClient := modelmanager.NewClient(conn)
+	defer sourceClient.Close()
+	targetClient := modelmanager.NewClient(targetConn)
+	defer targetClient.Close()
+
+	// the model is no longer reachable through this controller
+	c.cache.forget(input.UUID)
+
+	ctx, cancel := context.WithTimeout(context.Background(), ModelMigrationTimeout)
+	defer cancel()
+
+	return waitForModelMigration(ctx, sourceClient, targetClient, input.UUID, ModelMigrationTickWait)
+}
+
+// modelMigrationSpec returns the spec migrating the model to the target
+// controller, reached with targetConfig, or nil if the source and target
+// connections are to the same controller.
+func modelMigrationSpec(uuid string, source, target controllerTagAPI, targetConfig Configuration) *apicontroller.MigrationSpec {
+	if source.ControllerTag() == target.ControllerTag() {
+		return nil
+	}
+	return &apicontroller.MigrationSpec{
+		ModelUUID:            uuid,
+		TargetControllerUUID: target.ControllerTag().Id(),
+		TargetAddrs:          targetConfig.ControllerAddresses,
+		TargetCACert:         targetConfig.CACert,
+		TargetUser:           targetConfig.Username,
+		TargetPassword:       targetConfig.Password,
+	}
+}
+
+// initiateModelMigration validates and starts the migration described
+// by spec, returning the migration ID.
+func initiateModelMigration(client migrationControllerAPI, spec apicontroller.MigrationSpec) (string, error) {
+	if err := spec.Validate(); err != nil {
+		return "", err
+	}
+	migrationID, err := client.InitiateMigration(spec)
+	if err != nil {
+		return "", errors.Wrapf(err, "initiating migration of model %s", spec.ModelUUID)
+	}
+	return migrationID, nil
+}
+
+// waitForModelMigration polls the source and target controllers until
+// the model has left the source and is alive on the target. An aborted
+// migration is returned as an error.
+func waitForModelMigration(ctx context.Context, source, target modelInfoAPI, uuid string, tickTime time.Duration) error {
+	tags := []names.ModelTag{names.NewModelTag(uuid)}
+
+	tick := time.NewTicker(tickTime)
+	defer tick.Stop()
+	for {
+		select {
+		case <-tick.C:
+			results, err := source.ModelInfo(tags)
+			if err != nil {
+				return err
+			}
+			if len(results) != 1 {
+				return fmt.Errorf("expected one model returned for UUID: %s, received %d", uuid, len(results))
+			}
+
+			if results[0].Error == nil {
+				migration := results[0].Result.Migration
+				if migration != nil && migration.End != nil && strings.HasPrefix(migration.Status, "aborted") {
+					return fmt.Errorf("migration of model %s failed: %s", uuid, migration.Status)
+				}
+				// the migration is still running
+				continue
+			}
+			if !params.IsCodeNotFoundOrCodeUnauthorized(results[0].Error) {
+				return results[0].Error
+			}
+
+			// the model is gone from the source, check it is
+			// already available on the target
+			results, err = target.ModelInfo(tags)
+			if err != nil {
+				return err
+			}
+			if len(results) == 1 && results[0].Error == nil && results[0].Result.Life == life.Alive {
+				return nil
+			}
+		case <-ctx.Done():
+			return fmt.Errorf("timed out waiting for model %s to migrate", uuid)
+		}
+	}
+}
+
+// ReadModelDefaults returns the model defaults set for a cloud, or for a
+// region of the cloud when a region is given. Defaults inherited from
+// Juju itself are not included.
+func (c *modelsClient) ReadModelDefaults(input ReadModelDefaultsInput) (*ReadModelDefaultsResponse, error) {
+	conn, err := c.GetConnection(nil)
+	if err != nil {
+		return nil, err
+	}
 
 	client := modelmanager.NewClient(conn)
 	defer client.Close()
 
-	err = client.GrantModel(input.User, input.Access, input.ModelUUIDs...)
+	defaults, err := client.ModelDefaults(input.Cloud)
+	if err != nil {
+		return nil, err
+	}
+
+	config := make(map[string]interface{})
+	for key, value := range defaults {
+		if input.Region == "" {
+			if value.Controller != nil {
+				config[key] = value.Controller
+			}
+			continue
+		}
+		for _, region := range value.Regions {
+			if region.Name == input.Region {
+				config[key] = region.Value
+				break
+			}
+		}
+	}
+
+	return &ReadModelDefaultsResponse{
+		Config: config,
+	}, nil
+}
+
+func (c *modelsClient) UpdateModelDefaults(input UpdateModelDefaultsInput) error {
+	conn, err := c.GetConnection(nil)
 	if err != nil {
 		return err
 	}
 
+	client := modelmanager.NewClient(conn)
+	defer client.Close()
+
+	if input.Config != nil {
+		err = client.SetModelDefaults(input.Cloud, input.Region, input.Config)
+		if err != nil {
+			return err
+		}
+	}
+
+	if input.Unset != nil {
+		err = client.UnsetModelDefaults(input.Cloud, input.Region, input.Unset...)
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
-// Note we do a revoke against `read` to remove the user from the model access
-// If a user has had `write`, then removing that access would decrease their
-// access to `read` and the user will remain part of the model access.
-func (c *modelsClient) UpdateAccessModel(input UpdateAccessModelInput) error {
-	id := strings.Split(input.Model, ":")
-	model := id[0]
-	access := id[1]
+func (c *modelsClient) GrantModel(input GrantModelInput) error {
+	conn, err := c.GetConnection(nil)
+	if err != nil {
+		return err
+	}
+
+	client := modelmanager.NewClient(conn)
+	defer client.Close()
 
-	uuid, err := c.ResolveModelUUID(model)
+	err = client.GrantModel(input.User, input.Access, input.ModelUUIDs...)
 	if err != nil {
 		return err
 	}
 
+	return nil
+}
+
+// Note we do a revoke against `read` to remove the user from the model
+// access: revoking `read` removes any access of the user to the model.
+func (c *modelsClient) UpdateAccessModel(input UpdateAccessModelInput) error {
 	conn, err := c.GetConnection(nil)
 	if err != nil {
 		return err
@@ -388,14 +859,14 @@ func (c *modelsClient) UpdateAccessModel(input UpdateAccessModelInput) error {
 	defer client.Close()
 
 	for _, user := range input.Revoke {
-		err := client.RevokeModel(user, "read", uuid)
+		err := client.RevokeModel(user, "read", input.ModelUUID)
 		if err != nil {
 			return err
 		}
 	}
 
 	for _, user := range input.Grant {
-		err := client.GrantModel(user, access, uuid)
+		err := client.GrantModel(user, input.Access, input.ModelUUID)
 		if err != nil {
 			return err
 		}
@@ -404,18 +875,52 @@ func (c *modelsClient) UpdateAccessModel(input UpdateAccessModelInput) error {
 	return nil
 }
 
-// Note we do a revoke against `read` to remove the user from the model access
-// If a user has had `write`, then removing that access would decrease their
-// access to `read` and the user will remain part of the model access.
-func (c *modelsClient) DestroyAccessModel(input DestroyAccessModelInput) error {
-	id := strings.Split(input.Model, ":")
-	model := id[0]
+// ReadAccessModel returns the access of the users of the model.
+func (c *modelsClient) ReadAccessModel(input ReadAccessModelInput) (*ReadAccessModelResponse, error) {
+	conn, err := c.GetConnection(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	client := modelmanager.NewClient(conn)
+	defer client.Close()
+
+	return readAccessModel(client, input.ModelUUID)
+}
+
+// SetAccessModel grants, downgrades or revokes the access of the users
+// of the model so that exactly the given users have the given access.
+func (c *modelsClient) SetAccessModel(input SetAccessModelInput) error {
+	conn, err := c.GetConnection(nil)
+	if err != nil {
+		return err
+	}
 
-	uuid, err := c.ResolveModelUUID(model)
+	client := modelmanager.NewClient(conn)
+	defer client.Close()
+
+	current, err := readAccessModel(client, input.ModelUUID)
 	if err != nil {
 		return err
 	}
 
+	for _, change := range modelAccessChanges(current, input.Users, input.Access) {
+		if change.Revoke {
+			err = client.RevokeModel(change.User, change.Access, input.ModelUUID)
+		} else {
+			err = client.GrantModel(change.User, change.Access, input.ModelUUID)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Note we do a revoke against `read` to remove the user from the model
+// access: revoking `read` removes any access of the user to the model.
+func (c *modelsClient) DestroyAccessModel(input DestroyAccessModelInput) error {
 	conn, err := c.GetConnection(nil)
 	if err != nil {
 		return err
@@ -425,7 +930,7 @@ func (c *modelsClient) DestroyAccessModel(input DestroyAccessModelInput) error {
 	defer client.Close()
 
 	for _, user := range input.Revoke {
-		err := client.RevokeModel(user, "read", uuid)
+		err := client.RevokeModel(user, "read", input.ModelUUID)
 		if err != nil {
 			return err
 		}
@@ -433,3 +938,88 @@ func (c *modelsClient) DestroyAccessModel(input DestroyAccessModelInput) error {
 
 	return nil
 }
+
+func readAccessModel(client *modelmanager.Client, uuid string) (*ReadAccessModelResponse, error) {
+	models, err := client.ModelInfo([]names.ModelTag{names.NewModelTag(uuid)})
+	if err != nil {
+		return nil, err
+	}
+	if len(models) != 1 {
+		return nil, fmt.Errorf("expected 1 model for UUID %s, got %d", uuid, len(models))
+	}
+	if models[0].Error != nil {
+		if params.IsCodeNotFound(models[0].Error) {
+			return nil, &ModelNotFoundError{Model: uuid}
+		}
+		return nil, models[0].Error
+	}
+
+	modelInfo := models[0].Result
+	owner, err := names.ParseUserTag(modelInfo.OwnerTag)
+	if err != nil {
+		return nil, err
+	}
+	users := make(map[string]string)
+	for _, user := range modelInfo.Users {
+		users[user.UserName] = string(user.Access)
+	}
+
+	return &ReadAccessModelResponse{
+		Owner: owner.Id(),
+		Users: users,
+	}, nil
+}
+
+// modelAccessLevels are the model access levels, from the lowest.
+var modelAccessLevels = []string{"read", "write", "admin"}
+
+// modelAccessChange is a grant or a revoke of a model access.
+type modelAccessChange struct {
+	User   string
+	Revoke bool
+	Access string
+}
+
+// modelAccessChanges returns the changes giving exactly the access to
+// the users, and removing the other users having it from the model. As
+// revoking a model access sets the access below it, over privileged
+// users are downgraded by revoking the access above the wanted one. The
+// owner of the model is left alone.
+func modelAccessChanges(current *ReadAccessModelResponse, users []string, access string) []modelAccessChange {
+	level := ModelAccessLevel(access)
+	wanted := make(map[string]bool)
+
+	var changes []modelAccessChange
+	for _, user := range users {
+		wanted[user] = true
+		if user == current.Owner {
+			continue
+		}
+		currentLevel := ModelAccessLevel(current.Users[user])
+		switch {
+		case currentLevel < level:
+			changes = append(changes, modelAccessChange{User: user, Access: access})
+		case currentLevel > level:
+			changes = append(changes, modelAccessChange{User: user, Revoke: true, Access: modelAccessLevels[level+1]})
+		}
+	}
+
+	var extra []string
+	for user, userAccess := range current.Users {
+		if userAccess == access && !wanted[user] && user != current.Owner {
+			extra = append(extra, user)
+		}
+	}
+	sort.Strings(extra)
+	for _, user := range extra {
+		changes = append(changes, modelAccessChange{User: user, Revoke: true, Access: "read"})
+	}
+
+	return changes
+}
+
+// ModelAccessLevel returns the index of the access in modelAccessLevels,
+// -1 for no access.
+func ModelAccessLevel(access string) int {
+	return accessLevel(modelAccessLevels, access)
+}
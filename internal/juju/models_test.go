@@ -0,0 +1,410 @@
+package juju
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/juju/juju/api/base"
+	apicontroller "github.com/juju/juju/api/controller/controller"
+	"github.com/juju/juju/core/life"
+	"github.com/juju/juju/core/model"
+	"github.com/juju/juju/rpc/params"
+	"github.com/juju/names/v4"
+)
+
+const (
+	testModelUUID      = "a2ae5a8e-7a0b-4a9f-8ed7-4d1bb5a31a2f"
+	testControllerUUID = "f47ac10b-58cc-4372-a567-0e02b2c3d479"
+)
+
+// fakeControllerAPI records the migrations it is asked to start.
+type fakeControllerAPI struct {
+	specs []apicontroller.MigrationSpec
+	err   error
+}
+
+func (f *fakeControllerAPI) InitiateMigration(spec apicontroller.MigrationSpec) (string, error) {
+	f.specs = append(f.specs, spec)
+	if f.err != nil {
+		return "", f.err
+	}
+	return spec.ModelUUID + ":0", nil
+}
+
+// fakeModelManagerAPI returns a different ModelInfo result on every
+// call, repeating the last one once they are exhausted.
+type fakeModelManagerAPI struct {
+	results []params.ModelInfoResult
+	calls   int
+}
+
+func (f *fakeModelManagerAPI) ModelInfo(tags []names.ModelTag) ([]params.ModelInfoResult, error) {
+	i := f.calls
+	if i >= len(f.results) {
+		i = len(f.results) - 1
+	}
+	f.calls++
+	return []params.ModelInfoResult{f.results[i]}, nil
+}
+
+// fakeConnection is an API connection to the controller with the
+// given UUID.
+type fakeConnection struct {
+	controllerUUID string
+}
+
+func (f fakeConnection) ControllerTag() names.ControllerTag {
+	return names.NewControllerTag(f.controllerUUID)
+}
+
+func migratingModel(status string, end *time.Time) params.ModelInfoResult {
+	start := time.Now()
+	return params.ModelInfoResult{Result: &params.ModelInfo{
+		UUID: testModelUUID,
+		Life: life.Alive,
+		Migration: &params.ModelMigrationStatus{
+			Status: status,
+			Start:  &start,
+			End:    end,
+		},
+	}}
+}
+
+func aliveModel() params.ModelInfoResult {
+	return params.ModelInfoResult{Result: &params.ModelInfo{
+		UUID: testModelUUID,
+		Life: life.Alive,
+	}}
+}
+
+func notFoundModel() params.ModelInfoResult {
+	return params.ModelInfoResult{Error: &params.Error{
+		Message: "model not found",
+		Code:    params.CodeNotFound,
+	}}
+}
+
+func TestInitiateModelMigration(t *testing.T) {
+	client := &fakeControllerAPI{}
+	spec := apicontroller.MigrationSpec{
+		ModelUUID:            testModelUUID,
+		TargetControllerUUID: testControllerUUID,
+		TargetAddrs:          []string{"10.0.0.1:17070"},
+		TargetUser:           "admin",
+		TargetPassword:       "secret",
+	}
+
+	id, err := initiateModelMigration(client, spec)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if id != testModelUUID+":0" {
+		t.Errorf("unexpected migration ID %q", id)
+	}
+	if len(client.specs) != 1 || client.specs[0].TargetControllerUUID != testControllerUUID {
+		t.Errorf("unexpected migrations started: %+v", client.specs)
+	}
+}
+
+func TestInitiateModelMigrationInvalidSpec(t *testing.T) {
+	client := &fakeControllerAPI{}
+	spec := apicontroller.MigrationSpec{
+		ModelUUID:            testModelUUID,
+		TargetControllerUUID: testControllerUUID,
+		TargetUser:           "admin",
+		TargetPassword:       "secret",
+	}
+
+	if _, err := initiateModelMigration(client, spec); err == nil {
+		t.Fatal("expected an error for a spec without target addresses")
+	}
+	if len(client.specs) != 0 {
+		t.Errorf("no migration should have been started, got %+v", client.specs)
+	}
+}
+
+func TestInitiateModelMigrationError(t *testing.T) {
+	client := &fakeControllerAPI{err: errors.New("model has pending actions")}
+	spec := apicontroller.MigrationSpec{
+		ModelUUID:            testModelUUID,
+		TargetControllerUUID: testControllerUUID,
+		TargetAddrs:          []string{"10.0.0.1:17070"},
+		TargetUser:           "admin",
+		TargetPassword:       "secret",
+	}
+
+	_, err := initiateModelMigration(client, spec)
+	if err == nil || !strings.Contains(err.Error(), "model has pending actions") {
+		t.Fatalf("expected the facade error to be returned, got %v", err)
+	}
+}
+
+func TestModelMigrationSpec(t *testing.T) {
+	target := Configuration{
+		ControllerAddresses: []string{"10.0.0.1:17070"},
+		Username:            "admin",
+		Password:            "secret",
+		CACert:              "ca-cert",
+	}
+
+	spec := modelMigrationSpec(testModelUUID, fakeConnection{"source"}, fakeConnection{testControllerUUID}, target)
+	if spec == nil {
+		t.Fatal("expected a migration to another controller")
+	}
+	expected := apicontroller.MigrationSpec{
+		ModelUUID:            testModelUUID,
+		TargetControllerUUID: testControllerUUID,
+		TargetAddrs:          []string{"10.0.0.1:17070"},
+		TargetCACert:         "ca-cert",
+		TargetUser:           "admin",
+		TargetPassword:       "secret",
+	}
+	if !reflect.DeepEqual(*spec, expected) {
+		t.Errorf("unexpected spec %+v", *spec)
+	}
+}
+
+func TestModelMigrationSpecSameController(t *testing.T) {
+	// only the credentials used to reach the controller changed
+	target := Configuration{
+		ControllerAddresses: []string{"10.0.0.1:17070"},
+		Username:            "admin",
+		Password:            "rotated-secret",
+	}
+
+	spec := modelMigrationSpec(testModelUUID, fakeConnection{testControllerUUID}, fakeConnection{testControllerUUID}, target)
+	if spec != nil {
+		t.Errorf("no migration expected within the same controller, got %+v", *spec)
+	}
+}
+
+func TestWaitForModelMigration(t *testing.T) {
+	source := &fakeModelManagerAPI{results: []params.ModelInfoResult{
+		migratingModel("starting", nil),
+		migratingModel("importing", nil),
+		notFoundModel(),
+	}}
+	target := &fakeModelManagerAPI{results: []params.ModelInfoResult{
+		notFoundModel(),
+		aliveModel(),
+	}}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := waitForModelMigration(ctx, source, target, testModelUUID, time.Millisecond); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if target.calls != 2 {
+		t.Errorf("expected the target to be queried twice, got %d", target.calls)
+	}
+}
+
+func TestWaitForModelMigrationAborted(t *testing.T) {
+	end := time.Now()
+	source := &fakeModelManagerAPI{results: []params.ModelInfoResult{
+		migratingModel("starting", nil),
+		migratingModel("aborted, removing model from target controller: boom", &end),
+	}}
+	target := &fakeModelManagerAPI{results: []params.ModelInfoResult{notFoundModel()}}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	err := waitForModelMigration(ctx, source, target, testModelUUID, time.Millisecond)
+	if err == nil || !strings.Contains(err.Error(), "boom") {
+		t.Fatalf("expected the aborted migration to be reported, got %v", err)
+	}
+	if target.calls != 0 {
+		t.Errorf("the target should not be queried while the model is in the source, got %d calls", target.calls)
+	}
+}
+
+func TestWaitForModelMigrationTimeout(t *testing.T) {
+	source := &fakeModelManagerAPI{results: []params.ModelInfoResult{migratingModel("importing", nil)}}
+	target := &fakeModelManagerAPI{results: []params.ModelInfoResult{notFoundModel()}}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	err := waitForModelMigration(ctx, source, target, testModelUUID, time.Millisecond)
+	if err == nil || !strings.Contains(err.Error(), "timed out") {
+		t.Fatalf("expected a timeout, got %v", err)
+	}
+}
+
+func testModelSummaries() []base.UserModelSummary {
+	return []base.UserModelSummary{
+		{Name: "development", UUID: "uuid-admin-development", Owner: "admin", Cloud: "localhost", Type: model.IAAS},
+		{Name: "development", UUID: "uuid-alice-development", Owner: "alice", Cloud: "microk8s", Type: model.CAAS},
+		{Name: "development", UUID: "uuid-bob-development", Owner: "bob", Cloud: "localhost", Type: model.IAAS},
+		{Name: "production", UUID: "uuid-bob-production", Owner: "bob", Cloud: "aws", Type: model.IAAS},
+		{Name: "production-eu", UUID: "uuid-alice-production-eu", Owner: "alice", Cloud: "microk8s", Type: model.CAAS},
+	}
+}
+
+func TestMatchModelSummary(t *testing.T) {
+	tests := []struct {
+		about string
+		model string
+		user  string
+		uuid  string
+	}{{
+		about: "qualified name of another owner",
+		model: "alice/development",
+		user:  "admin",
+		uuid:  "uuid-alice-development",
+	}, {
+		about: "unqualified name prefers the current user",
+		model: "development",
+		user:  "admin",
+		uuid:  "uuid-admin-development",
+	}, {
+		about: "unqualified name owned by a single user",
+		model: "production",
+		user:  "admin",
+		uuid:  "uuid-bob-production",
+	}}
+
+	for _, test := range tests {
+		summary, err := matchModelSummary(testModelSummaries(), test.model, test.user)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", test.about, err)
+			continue
+		}
+		if summary.UUID != test.uuid {
+			t.Errorf("%s: expected model %s, got %s", test.about, test.uuid, summary.UUID)
+		}
+	}
+}
+
+func TestMatchModelSummaryAmbiguous(t *testing.T) {
+	_, err := matchModelSummary(testModelSummaries(), "development", "carol")
+
+	var ambiguousError *AmbiguousModelError
+	if !errors.As(err, &ambiguousError) {
+		t.Fatalf("expected an ambiguous model error, got %v", err)
+	}
+	expected := []string{"admin/development", "alice/development", "bob/development"}
+	if !reflect.DeepEqual(ambiguousError.Candidates, expected) {
+		t.Errorf("expected candidates %v, got %v", expected, ambiguousError.Candidates)
+	}
+}
+
+func TestMatchModelSummaryNotFound(t *testing.T) {
+	_, err := matchModelSummary(testModelSummaries(), "admin/production", "admin")
+
+	var notFoundError *ModelNotFoundError
+	if !errors.As(err, &notFoundError) {
+		t.Fatalf("expected a model not found error, got %v", err)
+	}
+	expected := []string{"alice/production-eu", "bob/production"}
+	if !reflect.DeepEqual(notFoundError.Candidates, expected) {
+		t.Errorf("expected candidates %v, got %v", expected, notFoundError.Candidates)
+	}
+}
+
+func TestModelUUIDCacheForget(t *testing.T) {
+	cache := &modelUUIDCache{uuids: make(map[string]string)}
+	cache.set("development", "uuid-admin-development")
+	cache.set("admin/development", "uuid-admin-development")
+	cache.set("bob/production", "uuid-bob-production")
+
+	cache.forget("uuid-admin-development")
+
+	if _, ok := cache.get("development"); ok {
+		t.Error("expected the unqualified entry to be forgotten")
+	}
+	if _, ok := cache.get("admin/development"); ok {
+		t.Error("expected the qualified entry to be forgotten")
+	}
+	if uuid, ok := cache.get("bob/production"); !ok || uuid != "uuid-bob-production" {
+		t.Errorf("expected other entries to be kept, got %q", uuid)
+	}
+}
+
+func TestFilterModelSummaries(t *testing.T) {
+	tests := []struct {
+		about string
+		input ListModelsInput
+		uuids []string
+	}{{
+		about: "no filters",
+		input: ListModelsInput{},
+		uuids: []string{"uuid-admin-development", "uuid-alice-development", "uuid-alice-production-eu", "uuid-bob-development", "uuid-bob-production"},
+	}, {
+		about: "owner",
+		input: ListModelsInput{Owner: "bob"},
+		uuids: []string{"uuid-bob-development", "uuid-bob-production"},
+	}, {
+		about: "cloud and type",
+		input: ListModelsInput{Cloud: "localhost", Type: "iaas"},
+		uuids: []string{"uuid-admin-development", "uuid-bob-development"},
+	}, {
+		about: "no match",
+		input: ListModelsInput{Owner: "alice", Type: "iaas"},
+		uuids: []string{},
+	}}
+
+	for _, test := range tests {
+		uuids := []string{}
+		for _, summary := range filterModelSummaries(testModelSummaries(), test.input) {
+			uuids = append(uuids, summary.UUID)
+		}
+		if !reflect.DeepEqual(uuids, test.uuids) {
+			t.Errorf("%s: expected models %v, got %v", test.about, test.uuids, uuids)
+		}
+	}
+}
+
+func TestModelAccessChanges(t *testing.T) {
+	current := &ReadAccessModelResponse{
+		Owner: "admin",
+		Users: map[string]string{
+			"admin":   "admin",
+			"alice":   "admin",
+			"bob":     "read",
+			"carol":   "write",
+			"dave":    "write",
+			"erin":    "read",
+			"mallory": "write",
+		},
+	}
+
+	changes := modelAccessChanges(current, []string{"alice", "bob", "carol", "frank", "admin"}, "write")
+	expected := []modelAccessChange{
+		// alice is downgraded from admin by revoking admin
+		{User: "alice", Revoke: true, Access: "admin"},
+		{User: "bob", Access: "write"},
+		{User: "frank", Access: "write"},
+		// the users having write but not listed are removed
+		{User: "dave", Revoke: true, Access: "read"},
+		{User: "mallory", Revoke: true, Access: "read"},
+	}
+	if !reflect.DeepEqual(changes, expected) {
+		t.Errorf("expected changes %v, got %v", expected, changes)
+	}
+
+	changes = modelAccessChanges(current, []string{"alice", "erin"}, "read")
+	expected = []modelAccessChange{
+		// admin is downgraded to read by revoking write
+		{User: "alice", Revoke: true, Access: "write"},
+		{User: "bob", Revoke: true, Access: "read"},
+	}
+	if !reflect.DeepEqual(changes, expected) {
+		t.Errorf("expected changes %v, got %v", expected, changes)
+	}
+
+	// the owner is never listed as a change
+	changes = modelAccessChanges(current, nil, "admin")
+	expected = []modelAccessChange{
+		{User: "alice", Revoke: true, Access: "read"},
+	}
+	if !reflect.DeepEqual(changes, expected) {
+		t.Errorf("expected changes %v, got %v", expected, changes)
+	}
+}
@@ -4,14 +4,18 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
+	"github.com/juju/charm/v8"
+	jujuerrors "github.com/juju/errors"
 	"github.com/juju/juju/api/client/application"
 	apiapplication "github.com/juju/juju/api/client/application"
 	"github.com/juju/juju/api/client/applicationoffers"
 	apiclient "github.com/juju/juju/api/client/client"
 	"github.com/juju/juju/core/crossmodel"
+	"github.com/juju/juju/core/permission"
 	"github.com/juju/juju/rpc/params"
 	"github.com/juju/names/v4"
 )
@@ -23,6 +27,11 @@ const (
 	// OfferApiTickWait is the time to wait between consecutive requests
 	// to the API
 	OfferApiTickWait = time.Second * 5
+	// OfferDestroyTimeout is the default time to wait for the connections
+	// to an offer to be removed before destroying it
+	OfferDestroyTimeout = time.Minute * 5
+	// everyoneUserName is the group of all the users of the controller
+	everyoneUserName = "everyone@external"
 )
 
 type offersClient struct {
@@ -31,15 +40,28 @@ type offersClient struct {
 
 type CreateOfferInput struct {
 	ApplicationName string
-	Endpoint        string
+	Endpoints       []string
+	Description     string
 	ModelName       string
 	ModelUUID       string
 	Name            string
+	// Owner of the offer, the authenticated user when empty
+	Owner string
 }
 
 type CreateOfferResponse struct {
 	Name     string
 	OfferURL string
+	Owner    string
+}
+
+type UpdateOfferInput struct {
+	ApplicationName string
+	Endpoints       []string
+	Description     string
+	ModelUUID       string
+	Name            string
+	Owner           string
 }
 
 type ReadOfferInput struct {
@@ -48,19 +70,98 @@ type ReadOfferInput struct {
 
 type ReadOfferResponse struct {
 	ApplicationName string
-	Endpoint        string
+	Endpoints       []string
+	Description     string
 	ModelName       string
+	ModelOwner      string
 	Name            string
 	OfferURL        string
+	// Users maps the users able to access the offer to their access
+	Users map[string]string
 }
 
 type DestroyOfferInput struct {
 	OfferURL string
+	// Timeout is how long to wait for the consumers to remove their
+	// connections, OfferDestroyTimeout when zero
+	Timeout time.Duration
+	// ForceOnTimeout destroys the offer and its remaining connections
+	// once the timeout elapsed
+	ForceOnTimeout bool
+}
+
+type DestroyOfferResponse struct {
+	// ForcedConnections are the connections severed by forcing the
+	// removal of the offer
+	ForcedConnections []crossmodel.OfferConnection
+}
+
+// OfferConnectionsError is returned when an offer can not be destroyed
+// because consumers still use it.
+type OfferConnectionsError struct {
+	OfferURL    string
+	Connections []crossmodel.OfferConnection
+}
+
+func (e *OfferConnectionsError) Error() string {
+	return fmt.Sprintf("offer %s still has %d connection(s)", e.OfferURL, len(e.Connections))
+}
+
+type GrantOfferInput struct {
+	User     string
+	Access   string
+	OfferURL string
+}
+
+type UpdateAccessOfferInput struct {
+	OfferURL string
+	Grant    []string
+	Revoke   []string
+	Access   string
+}
+
+type DestroyAccessOfferInput struct {
+	OfferURL string
+	Revoke   []string
+}
+
+type FindOffersInput struct {
+	Owner       string
+	ModelName   string
+	Application string
+	Interface   string
+	Role        string
+	// AllowedConsumer only keeps the offers this user can consume
+	AllowedConsumer string
+}
+
+type FindOffersResponse struct {
+	Offers []OfferSummary
+}
+
+type OfferSummary struct {
+	Name            string
+	OfferURL        string
+	ApplicationName string
+	Description     string
+	Endpoints       []OfferEndpoint
+	ConnectionCount int
+	// Access of the authenticated user to the offer
+	Access string
+}
+
+type OfferEndpoint struct {
+	Name      string
+	Interface string
+	Role      string
 }
 
 type ConsumeRemoteOfferInput struct {
 	ModelUUID string
 	OfferURL  string
+	// Alias is the name of the SAAS application in the model, the
+	// offer name is used when empty
+	Alias string
 }
 
 type ConsumeRemoteOfferResponse struct {
@@ -72,6 +173,24 @@ type RemoveRemoteOfferInput struct {
 	OfferURL  string
 }
 
+type ReadSAASInput struct {
+	ModelUUID string
+	Name      string
+}
+
+type ReadSAASResponse struct {
+	Name      string
+	OfferURL  string
+	OfferName string
+	Endpoints []string
+	Status    string
+}
+
+type DestroySAASInput struct {
+	ModelUUID string
+	Name      string
+}
+
 func newOffersClient(cf ConnectionFactory) *offersClient {
 	return &offersClient{
 		ConnectionFactory: cf,
@@ -112,7 +231,12 @@ func (c offersClient) CreateOffer(input *CreateOfferInput) (*CreateOfferResponse
 		return nil, append(errs, errors.New("the application was not available to be offered"))
 	}
 
-	result, err := client.Offer(input.ModelUUID, input.ApplicationName, []string{input.Endpoint}, "admin", offerName, "")
+	owner := input.Owner
+	if owner == "" {
+		owner = strings.TrimPrefix(conn.AuthTag().String(), PrefixUser)
+	}
+
+	result, err := client.Offer(input.ModelUUID, input.ApplicationName, input.Endpoints, owner, offerName, input.Description)
 	if err != nil {
 		return nil, append(errs, err)
 	}
@@ -142,10 +266,40 @@ func (c offersClient) CreateOffer(input *CreateOfferInput) (*CreateOfferResponse
 	resp := CreateOfferResponse{
 		Name:     offer.OfferName,
 		OfferURL: offer.OfferURL,
+		Owner:    owner,
 	}
 	return &resp, nil
 }
 
+// UpdateOffer offers the application again under the same offer name,
+// which replaces the endpoints and the description of the offer.
+func (c offersClient) UpdateOffer(input *UpdateOfferInput) error {
+	conn, err := c.GetConnection(nil)
+	if err != nil {
+		return err
+	}
+
+	client := applicationoffers.NewClient(conn)
+	defer client.Close()
+
+	owner := input.Owner
+	if owner == "" {
+		owner = strings.TrimPrefix(conn.AuthTag().String(), PrefixUser)
+	}
+
+	results, err := client.Offer(input.ModelUUID, input.ApplicationName, input.Endpoints, owner, input.Name, input.Description)
+	if err != nil {
+		return err
+	}
+	for _, result := range results {
+		if result.Error != nil {
+			return result.Error
+		}
+	}
+
+	return nil
+}
+
 func (c offersClient) ReadOffer(input *ReadOfferInput) (*ReadOfferResponse, error) {
 	conn, err := c.GetConnection(nil)
 	if err != nil {
@@ -156,6 +310,9 @@ func (c offersClient) ReadOffer(input *ReadOfferInput) (*ReadOfferResponse, erro
 	defer client.Close()
 
 	result, err := client.ApplicationOffer(input.OfferURL)
+	if params.IsCodeNotFound(err) {
+		return nil, jujuerrors.NotFoundf("offer %s", input.OfferURL)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -164,20 +321,30 @@ func (c offersClient) ReadOffer(input *ReadOfferInput) (*ReadOfferResponse, erro
 	response.Name = result.OfferName
 	response.ApplicationName = result.ApplicationName
 	response.OfferURL = result.OfferURL
-	response.Endpoint = result.Endpoints[0].Name
+	response.Description = result.ApplicationDescription
+	for _, endpoint := range result.Endpoints {
+		response.Endpoints = append(response.Endpoints, endpoint.Name)
+	}
+	sort.Strings(response.Endpoints)
 
 	//no model name is returned but it can be parsed from the resulting offer URL to ensure parity
 	//TODO: verify if we can fetch information another way
-	modelName, ok := parseModelFromURL(result.OfferURL)
-	if !ok {
-		return nil, fmt.Errorf("unable to parse model name from offer URL")
+	url, err := crossmodel.ParseOfferURL(result.OfferURL)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse model name from offer URL: %s", err)
+	}
+	response.ModelName = url.ModelName
+	response.ModelOwner = url.User
+
+	response.Users = make(map[string]string, len(result.Users))
+	for _, user := range result.Users {
+		response.Users[user.UserName] = string(user.Access)
 	}
-	response.ModelName = modelName
 
 	return &response, nil
 }
 
-func (c offersClient) DestroyOffer(input *DestroyOfferInput) error {
+func (c offersClient) GrantOffer(input *GrantOfferInput) error {
 	conn, err := c.GetConnection(nil)
 	if err != nil {
 		return err
@@ -186,63 +353,242 @@ func (c offersClient) DestroyOffer(input *DestroyOfferInput) error {
 	client := applicationoffers.NewClient(conn)
 	defer client.Close()
 
-	offer, err := client.ApplicationOffer(input.OfferURL)
+	return client.GrantOffer(input.User, input.Access, input.OfferURL)
+}
+
+// Note we do a revoke against `read` to remove the user from the offer
+// access: revoking `read` removes any access of the user to the offer.
+func (c offersClient) UpdateAccessOffer(input *UpdateAccessOfferInput) error {
+	conn, err := c.GetConnection(nil)
 	if err != nil {
 		return err
 	}
 
-	forceDestroy := false
-	//This code loops until it detects 0 connections in the offer or 3 minutes elapses
-	if len(offer.Connections) > 0 {
-		end := time.Now().Add(5 * time.Minute)
-		for ok := true; ok; ok = len(offer.Connections) > 0 {
-			//if we have been failing to destroy offer for 5 minutes then force destroy
-			//TODO: investigate cleaner solution (acceptance tests fail even if timeout set to 20m)
-			if time.Now().After(end) {
-				forceDestroy = true
-				break
-			}
-			time.Sleep(10 * time.Second)
-			offer, err = client.ApplicationOffer(input.OfferURL)
-			if err != nil {
-				return err
-			}
+	client := applicationoffers.NewClient(conn)
+	defer client.Close()
+
+	for _, user := range input.Revoke {
+		err := client.RevokeOffer(user, "read", input.OfferURL)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, user := range input.Grant {
+		err := client.GrantOffer(user, input.Access, input.OfferURL)
+		if err != nil {
+			return err
 		}
 	}
 
-	err = client.DestroyOffers(forceDestroy, input.OfferURL)
+	return nil
+}
+
+// Note we do a revoke against `read` to remove the user from the offer access.
+func (c offersClient) DestroyAccessOffer(input *DestroyAccessOfferInput) error {
+	conn, err := c.GetConnection(nil)
 	if err != nil {
 		return err
 	}
 
+	client := applicationoffers.NewClient(conn)
+	defer client.Close()
+
+	for _, user := range input.Revoke {
+		err := client.RevokeOffer(user, "read", input.OfferURL)
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
-func findApplicationOffers(client *applicationoffers.Client, filter crossmodel.ApplicationOfferFilter) (*crossmodel.ApplicationOfferDetails, error) {
+// DestroyOffer waits for the consumers to remove their connections to the
+// offer before destroying it. When connections remain after the timeout,
+// the offer is either forcibly destroyed, severing them, or an
+// OfferConnectionsError listing them is returned.
+func (c offersClient) DestroyOffer(ctx context.Context, input *DestroyOfferInput) (*DestroyOfferResponse, error) {
+	conn, err := c.GetConnection(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	client := applicationoffers.NewClient(conn)
+	defer client.Close()
+
+	timeout := input.Timeout
+	if timeout == 0 {
+		timeout = OfferDestroyTimeout
+	}
+	waitCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	connections, err := waitForOfferConnections(waitCtx, client, input.OfferURL, OfferApiTickWait)
+	if err != nil {
+		return nil, err
+	}
+	// an interrupted wait must not force the removal of the offer
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if len(connections) > 0 && !input.ForceOnTimeout {
+		return nil, &OfferConnectionsError{
+			OfferURL:    input.OfferURL,
+			Connections: connections,
+		}
+	}
+
+	err = client.DestroyOffers(len(connections) > 0, input.OfferURL)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DestroyOfferResponse{
+		ForcedConnections: connections,
+	}, nil
+}
+
+// offerAPI is the part of the applicationoffers facade used to follow
+// the connections to an offer.
+type offerAPI interface {
+	ApplicationOffer(urlStr string) (*crossmodel.ApplicationOfferDetails, error)
+}
+
+// waitForOfferConnections waits until the offer has no connections left
+// or the context is done, returning the remaining connections.
+func waitForOfferConnections(ctx context.Context, client offerAPI, offerURL string, tickTime time.Duration) ([]crossmodel.OfferConnection, error) {
+	tick := time.NewTicker(tickTime)
+	defer tick.Stop()
+
+	for {
+		offer, err := client.ApplicationOffer(offerURL)
+		if err != nil {
+			return nil, err
+		}
+		if len(offer.Connections) == 0 {
+			return nil, nil
+		}
+
+		select {
+		case <-tick.C:
+		case <-ctx.Done():
+			return offer.Connections, nil
+		}
+	}
+}
+
+// FindOffers searches the offers of all the models visible to the user.
+// The applicationoffers facade only filters on the owner, model and
+// endpoints, the application and allowed consumer are filtered here.
+func (c offersClient) FindOffers(input *FindOffersInput) (*FindOffersResponse, error) {
+	conn, err := c.GetConnection(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	client := applicationoffers.NewClient(conn)
+	defer client.Close()
+
+	filter := crossmodel.ApplicationOfferFilter{
+		OwnerName: input.Owner,
+		ModelName: input.ModelName,
+	}
+	if input.Interface != "" || input.Role != "" {
+		filter.Endpoints = []crossmodel.EndpointFilterTerm{{
+			Interface: input.Interface,
+			Role:      charm.RelationRole(input.Role),
+		}}
+	}
+
 	offers, err := client.FindApplicationOffers(filter)
 	if err != nil {
 		return nil, err
 	}
 
-	if len(offers) > 1 || len(offers) == 0 {
-		return nil, fmt.Errorf("unable to find offer after creation")
+	currentUser := strings.TrimPrefix(conn.AuthTag().String(), PrefixUser)
+	return &FindOffersResponse{
+		Offers: filterOffers(offers, input, currentUser),
+	}, nil
+}
+
+// OfferAccessLevels are the offer access levels, from the lowest. Each
+// level grants the permissions of the previous ones.
+var OfferAccessLevels = []string{
+	string(permission.ReadAccess),
+	string(permission.ConsumeAccess),
+	string(permission.AdminAccess),
+}
+
+// OfferAccessLevel returns the index of the access in OfferAccessLevels,
+// -1 for no access.
+func OfferAccessLevel(access string) int {
+	return accessLevel(OfferAccessLevels, access)
+}
+
+// offerUserAccess returns the access of user to the offer, taking the
+// access granted to everyone into account.
+func offerUserAccess(offer *crossmodel.ApplicationOfferDetails, user string) string {
+	access := ""
+	for _, offerUser := range offer.Users {
+		if offerUser.UserName != user && offerUser.UserName != everyoneUserName {
+			continue
+		}
+		if OfferAccessLevel(string(offerUser.Access)) > OfferAccessLevel(access) {
+			access = string(offerUser.Access)
+		}
 	}
+	return access
+}
 
-	return offers[0], nil
+func filterOffers(offers []*crossmodel.ApplicationOfferDetails, input *FindOffersInput, currentUser string) []OfferSummary {
+	summaries := []OfferSummary{}
+	for _, offer := range offers {
+		if input.Application != "" && offer.ApplicationName != input.Application {
+			continue
+		}
+		if input.AllowedConsumer != "" && OfferAccessLevel(offerUserAccess(offer, input.AllowedConsumer)) < OfferAccessLevel(string(permission.ConsumeAccess)) {
+			continue
+		}
+
+		summary := OfferSummary{
+			Name:            offer.OfferName,
+			OfferURL:        offer.OfferURL,
+			ApplicationName: offer.ApplicationName,
+			Description:     offer.ApplicationDescription,
+			ConnectionCount: len(offer.Connections),
+			Access:          offerUserAccess(offer, currentUser),
+		}
+		for _, endpoint := range offer.Endpoints {
+			summary.Endpoints = append(summary.Endpoints, OfferEndpoint{
+				Name:      endpoint.Name,
+				Interface: endpoint.Interface,
+				Role:      string(endpoint.Role),
+			})
+		}
+		sort.Slice(summary.Endpoints, func(i, j int) bool {
+			return summary.Endpoints[i].Name < summary.Endpoints[j].Name
+		})
+		summaries = append(summaries, summary)
+	}
+
+	sort.Slice(summaries, func(i, j int) bool {
+		return summaries[i].OfferURL < summaries[j].OfferURL
+	})
+	return summaries
 }
 
-func parseModelFromURL(url string) (result string, success bool) {
-	start := strings.Index(url, "/")
-	if start == -1 {
-		return result, false
+func findApplicationOffers(client *applicationoffers.Client, filter crossmodel.ApplicationOfferFilter) (*crossmodel.ApplicationOfferDetails, error) {
+	offers, err := client.FindApplicationOffers(filter)
+	if err != nil {
+		return nil, err
 	}
-	newURL := url[start+1:]
-	end := strings.Index(newURL, ".")
-	if end == -1 {
-		return result, false
+
+	if len(offers) > 1 || len(offers) == 0 {
+		return nil, fmt.Errorf("unable to find offer after creation")
 	}
-	result = newURL[:end]
-	return result, true
+
+	return offers[0], nil
 }
 
 // This function allows the integration resource to consume the offers managed by the offer resource
@@ -282,9 +628,14 @@ func (c offersClient) ConsumeRemoteOffer(input *ConsumeRemoteOfferInput) (*Consu
 	offerURL.Source = url.Source
 	consumeDetails.Offer.OfferURL = offerURL.String()
 
+	alias := input.Alias
+	if alias == "" {
+		alias = consumeDetails.Offer.OfferName
+	}
+
 	consumeArgs := crossmodel.ConsumeApplicationArgs{
 		Offer:            *consumeDetails.Offer,
-		ApplicationAlias: consumeDetails.Offer.OfferName,
+		ApplicationAlias: alias,
 		Macaroon:         consumeDetails.Macaroon,
 	}
 	if consumeDetails.ControllerInfo != nil {
@@ -373,3 +724,64 @@ func (c offersClient) RemoveRemoteOffer(input *RemoveRemoteOfferInput) []error {
 
 	return nil
 }
+
+// ReadSAAS returns the SAAS application consuming an offer in the model.
+func (c offersClient) ReadSAAS(input *ReadSAASInput) (*ReadSAASResponse, error) {
+	conn, err := c.GetConnection(&input.ModelUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	status, err := getStatus(conn)
+	if err != nil {
+		return nil, err
+	}
+
+	saas, exists := status.RemoteApplications[input.Name]
+	if !exists {
+		return nil, fmt.Errorf("saas %q not found in model", input.Name)
+	}
+	if saas.Err != nil {
+		return nil, saas.Err
+	}
+
+	endpoints := make([]string, 0, len(saas.Endpoints))
+	for _, endpoint := range saas.Endpoints {
+		endpoints = append(endpoints, endpoint.Name)
+	}
+	sort.Strings(endpoints)
+
+	return &ReadSAASResponse{
+		Name:      input.Name,
+		OfferURL:  saas.OfferURL,
+		OfferName: saas.OfferName,
+		Endpoints: endpoints,
+		Status:    saas.Status.Status,
+	}, nil
+}
+
+// DestroySAAS removes a SAAS application from the model, along with its
+// integrations.
+func (c offersClient) DestroySAAS(input *DestroySAASInput) error {
+	conn, err := c.GetConnection(&input.ModelUUID)
+	if err != nil {
+		return err
+	}
+
+	client := apiapplication.NewClient(conn)
+	defer client.Close()
+
+	results, err := client.DestroyConsumedApplication(apiapplication.DestroyConsumedApplicationParams{
+		SaasNames: []string{input.Name},
+	})
+	if err != nil {
+		return err
+	}
+	for _, result := range results {
+		if result.Error != nil {
+			return result.Error
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,134 @@
+package juju
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/juju/charm/v8"
+	"github.com/juju/juju/core/crossmodel"
+	"github.com/juju/juju/core/permission"
+)
+
+func testOffers() []*crossmodel.ApplicationOfferDetails {
+	return []*crossmodel.ApplicationOfferDetails{{
+		OfferName:       "pg",
+		OfferURL:        "admin/prod.pg",
+		ApplicationName: "postgresql",
+		Endpoints: []charm.Relation{
+			{Name: "db-admin", Interface: "pgsql", Role: charm.RoleProvider},
+			{Name: "db", Interface: "pgsql", Role: charm.RoleProvider},
+		},
+		Connections: []crossmodel.OfferConnection{{RelationId: 1}, {RelationId: 2}},
+		Users: []crossmodel.OfferUserDetails{
+			{UserName: "admin", Access: permission.AdminAccess},
+			{UserName: "alice", Access: permission.ConsumeAccess},
+			{UserName: "bob", Access: permission.ReadAccess},
+		},
+	}, {
+		OfferName:       "mysql",
+		OfferURL:        "admin/dev.mysql",
+		ApplicationName: "mysql",
+		Endpoints: []charm.Relation{
+			{Name: "server", Interface: "mysql", Role: charm.RoleProvider},
+		},
+		Users: []crossmodel.OfferUserDetails{
+			{UserName: "admin", Access: permission.AdminAccess},
+			{UserName: everyoneUserName, Access: permission.ConsumeAccess},
+		},
+	}}
+}
+
+func TestFilterOffers(t *testing.T) {
+	offers := filterOffers(testOffers(), &FindOffersInput{}, "alice")
+	expected := []OfferSummary{{
+		Name:            "mysql",
+		OfferURL:        "admin/dev.mysql",
+		ApplicationName: "mysql",
+		Endpoints:       []OfferEndpoint{{Name: "server", Interface: "mysql", Role: "provider"}},
+		Access:          "consume",
+	}, {
+		Name:            "pg",
+		OfferURL:        "admin/prod.pg",
+		ApplicationName: "postgresql",
+		Endpoints: []OfferEndpoint{
+			{Name: "db", Interface: "pgsql", Role: "provider"},
+			{Name: "db-admin", Interface: "pgsql", Role: "provider"},
+		},
+		ConnectionCount: 2,
+		Access:          "consume",
+	}}
+	if !reflect.DeepEqual(offers, expected) {
+		t.Fatalf("unexpected offers: %+v", offers)
+	}
+}
+
+func TestFilterOffersByApplicationAndConsumer(t *testing.T) {
+	tests := []struct {
+		input    FindOffersInput
+		expected []string
+	}{
+		{input: FindOffersInput{Application: "postgresql"}, expected: []string{"admin/prod.pg"}},
+		{input: FindOffersInput{AllowedConsumer: "alice"}, expected: []string{"admin/dev.mysql", "admin/prod.pg"}},
+		{input: FindOffersInput{AllowedConsumer: "bob"}, expected: []string{"admin/dev.mysql"}},
+		{input: FindOffersInput{Application: "postgresql", AllowedConsumer: "bob"}, expected: []string{}},
+	}
+	for _, test := range tests {
+		urls := []string{}
+		for _, offer := range filterOffers(testOffers(), &test.input, "admin") {
+			urls = append(urls, offer.OfferURL)
+		}
+		if !reflect.DeepEqual(urls, test.expected) {
+			t.Errorf("%+v: expected %v, got %v", test.input, test.expected, urls)
+		}
+	}
+}
+
+type fakeOfferAPI struct {
+	connections []int
+	calls       int
+}
+
+func (f *fakeOfferAPI) ApplicationOffer(urlStr string) (*crossmodel.ApplicationOfferDetails, error) {
+	count := f.connections[len(f.connections)-1]
+	if f.calls < len(f.connections) {
+		count = f.connections[f.calls]
+	}
+	f.calls++
+	offer := &crossmodel.ApplicationOfferDetails{OfferURL: urlStr}
+	for i := 0; i < count; i++ {
+		offer.Connections = append(offer.Connections, crossmodel.OfferConnection{RelationId: i})
+	}
+	return offer, nil
+}
+
+func TestWaitForOfferConnections(t *testing.T) {
+	client := &fakeOfferAPI{connections: []int{2, 1, 0}}
+
+	connections, err := waitForOfferConnections(context.Background(), client, "admin/prod.pg", time.Millisecond)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(connections) != 0 {
+		t.Errorf("expected no remaining connections, got %d", len(connections))
+	}
+	if client.calls != 3 {
+		t.Errorf("expected 3 offer calls, got %d", client.calls)
+	}
+}
+
+func TestWaitForOfferConnectionsTimeout(t *testing.T) {
+	client := &fakeOfferAPI{connections: []int{2}}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	connections, err := waitForOfferConnections(ctx, client, "admin/prod.pg", time.Millisecond)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(connections) != 2 {
+		t.Errorf("expected 2 remaining connections, got %d", len(connections))
+	}
+}
@@ -3,8 +3,9 @@ package juju
 import (
 	"fmt"
 
+	"github.com/juju/errors"
 	"github.com/juju/juju/api/client/keymanager"
-	"github.com/juju/terraform-provider-juju/internal/utils"
+	"github.com/juju/juju/rpc/params"
 	"github.com/juju/utils/v3/ssh"
 	"github.com/rs/zerolog/log"
 )
@@ -20,20 +21,30 @@ type CreateSSHKeyInput struct {
 }
 
 type ReadSSHKeyInput struct {
-	ModelName string
-	ModelUUID string
-	User      string
+	ModelName   string
+	ModelUUID   string
+	Fingerprint string
 }
 
 type ReadSSHKeyOutput struct {
-	ModelName string
-	Payload   string
+	ModelName   string
+	Payload     string
+	Fingerprint string
+	Comment     string
 }
 
 type DeleteSSHKeyInput struct {
-	ModelName string
-	ModelUUID string
-	User      string
+	ModelName   string
+	ModelUUID   string
+	Fingerprint string
+}
+
+// sshKeysAPI is the part of the keymanager facade used to manage
+// the ssh keys of a model.
+type sshKeysAPI interface {
+	ListKeys(mode ssh.ListMode, users ...string) ([]params.StringsResult, error)
+	AddKeys(user string, keys ...string) ([]params.ErrorResult, error)
+	DeleteKeys(user string, keys ...string) ([]params.ErrorResult, error)
 }
 
 func newSSHKeysClient(cf ConnectionFactory) *sshKeysClient {
@@ -57,21 +68,7 @@ func (c *sshKeysClient) CreateSSHKey(input *CreateSSHKeyInput) error {
 	if err != nil {
 		return err
 	}
-	if len(params) != 0 {
-		messages := make([]string, 0)
-		for _, e := range params {
-			if e.Error != nil {
-				messages = append(messages, e.Error.Message)
-			}
-		}
-		if len(messages) == 0 {
-			return nil
-		}
-		err = fmt.Errorf("%s", messages)
-		return err
-	}
-
-	return nil
+	return errorResults(params)
 }
 
 func (c *sshKeysClient) ReadSSHKey(input *ReadSSHKeyInput) (*ReadSSHKeyOutput, error) {
@@ -83,25 +80,7 @@ func (c *sshKeysClient) ReadSSHKey(input *ReadSSHKeyInput) (*ReadSSHKeyOutput, e
 	client := keymanager.NewClient(conn)
 	defer client.Close()
 
-	// NOTE: At this moment Juju only uses global ssh keys.
-	// We hardcode the user to be admin.
-	returnedKeys, err := client.ListKeys(ssh.FullKeys, "admin")
-	if err != nil {
-		return nil, err
-	}
-
-	for _, res := range returnedKeys {
-		for _, k := range res.Result {
-			if input.User == utils.GetUserFromSSHKey(k) {
-				return &ReadSSHKeyOutput{
-					ModelName: input.ModelName,
-					Payload:   k,
-				}, nil
-			}
-		}
-	}
-
-	return nil, fmt.Errorf("no ssh key found for %s", input.User)
+	return readSSHKey(client, input)
 }
 
 func (c *sshKeysClient) DeleteSSHKey(input *DeleteSSHKeyInput) error {
@@ -113,40 +92,92 @@ func (c *sshKeysClient) DeleteSSHKey(input *DeleteSSHKeyInput) error {
 	client := keymanager.NewClient(conn)
 	defer client.Close()
 
+	return deleteSSHKey(client, input)
+}
+
+// readSSHKey returns the key of the model matching the fingerprint of
+// the input, or a NotFound error if the key is not in the model.
+func readSSHKey(client sshKeysAPI, input *ReadSSHKeyInput) (*ReadSSHKeyOutput, error) {
+	keys, err := listSSHKeys(client)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, k := range keys {
+		fingerprint, comment, err := ssh.KeyFingerprint(k)
+		if err != nil {
+			continue
+		}
+		if fingerprint == input.Fingerprint {
+			return &ReadSSHKeyOutput{
+				ModelName:   input.ModelName,
+				Payload:     k,
+				Fingerprint: fingerprint,
+				Comment:     comment,
+			}, nil
+		}
+	}
+
+	return nil, errors.NotFoundf("ssh key %s", input.Fingerprint)
+}
+
+func deleteSSHKey(client sshKeysAPI, input *DeleteSSHKeyInput) error {
 	// NOTE: Unfortunately Juju will return an error if we try to
 	// remove the last ssh key from the controller. This is something
 	// that impacts the current Juju logic. As a temporal workaround
 	// we will check if this is the latest SSH key of this model and
 	// skip the delete.
-	returnedKeys, err := client.ListKeys(ssh.FullKeys, "admin")
+	keys, err := listSSHKeys(client)
 	if err != nil {
 		return err
 	}
-	// only check if there is one key
-	if len(returnedKeys) == 1 {
-		k := returnedKeys[0].Result[0]
-		if input.User == utils.GetUserFromSSHKey(k) {
+	if len(keys) == 1 {
+		if fingerprint, _, err := ssh.KeyFingerprint(keys[0]); err == nil && fingerprint == input.Fingerprint {
 			// This is the latest key, do not remove it
-			log.Warn().Msgf("ssh key from user %s is the last one and will not be removed", input.User)
+			log.Warn().Msgf("ssh key %s is the last one and will not be removed", input.Fingerprint)
 			return nil
 		}
 	}
 
-	// NOTE: Right now Juju uses global users for keys
-	params, err := client.DeleteKeys("admin", input.User)
-	if len(params) != 0 {
-		messages := make([]string, 0)
-		for _, e := range params {
-			if e.Error != nil {
-				messages = append(messages, e.Error.Message)
-			}
-		}
-		if len(messages) == 0 {
-			return nil
-		}
-		err = fmt.Errorf("%s", messages)
+	// NOTE: Right now Juju uses global users for keys. Keys are
+	// removed by fingerprint, as several keys may share a comment.
+	params, err := client.DeleteKeys("admin", input.Fingerprint)
+	if err != nil {
 		return err
 	}
+	return errorResults(params)
+}
+
+// listSSHKeys returns the full keys of the model.
+func listSSHKeys(client sshKeysAPI) ([]string, error) {
+	// NOTE: At this moment Juju only uses global ssh keys.
+	// We hardcode the user to be admin.
+	results, err := client.ListKeys(ssh.FullKeys, "admin")
+	if err != nil {
+		return nil, err
+	}
 
-	return err
+	var keys []string
+	for _, res := range results {
+		if res.Error != nil {
+			return nil, res.Error
+		}
+		keys = append(keys, res.Result...)
+	}
+	return keys, nil
+}
+
+// errorResults returns an error gathering the messages of the failed
+// results, or nil if all of them succeeded.
+func errorResults(results []params.ErrorResult) error {
+	messages := make([]string, 0)
+	for _, e := range results {
+		if e.Error != nil {
+			messages = append(messages, e.Error.Message)
+		}
+	}
+	if len(messages) == 0 {
+		return nil
+	}
+	return fmt.Errorf("%s", messages)
 }
@@ -0,0 +1,87 @@
+package juju
+
+import (
+	"testing"
+
+	"github.com/juju/errors"
+	"github.com/juju/juju/rpc/params"
+	"github.com/juju/utils/v3/ssh"
+)
+
+const (
+	testSSHKey1 = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIORQYSFMu02Hi1/pFm2DZ76QZYptShRahdkhB+TyStdQ jimmy@somewhere"
+	testSSHKey2 = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIJFEnRAeBXhtlaDjEx94dwtjYXdjbWdl3cp8rvjBtN7W jimmy@somewhere"
+)
+
+type fakeSSHKeysAPI struct {
+	keys    []string
+	deleted []string
+}
+
+func (f *fakeSSHKeysAPI) ListKeys(mode ssh.ListMode, users ...string) ([]params.StringsResult, error) {
+	return []params.StringsResult{{Result: f.keys}}, nil
+}
+
+func (f *fakeSSHKeysAPI) AddKeys(user string, keys ...string) ([]params.ErrorResult, error) {
+	f.keys = append(f.keys, keys...)
+	return make([]params.ErrorResult, len(keys)), nil
+}
+
+func (f *fakeSSHKeysAPI) DeleteKeys(user string, keys ...string) ([]params.ErrorResult, error) {
+	f.deleted = append(f.deleted, keys...)
+	return make([]params.ErrorResult, len(keys)), nil
+}
+
+func sshKeyFingerprint(t *testing.T, key string) string {
+	fingerprint, _, err := ssh.KeyFingerprint(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return fingerprint
+}
+
+func TestReadSSHKeyByFingerprint(t *testing.T) {
+	client := &fakeSSHKeysAPI{keys: []string{testSSHKey1, testSSHKey2}}
+
+	// both keys share the comment, the fingerprint tells them apart
+	fingerprint := sshKeyFingerprint(t, testSSHKey2)
+	output, err := readSSHKey(client, &ReadSSHKeyInput{ModelName: "test", Fingerprint: fingerprint})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if output.Payload != testSSHKey2 {
+		t.Errorf("expected payload %q, got %q", testSSHKey2, output.Payload)
+	}
+	if output.Fingerprint != fingerprint {
+		t.Errorf("expected fingerprint %q, got %q", fingerprint, output.Fingerprint)
+	}
+	if output.Comment != "jimmy@somewhere" {
+		t.Errorf("expected comment %q, got %q", "jimmy@somewhere", output.Comment)
+	}
+
+	_, err = readSSHKey(&fakeSSHKeysAPI{keys: []string{testSSHKey1}}, &ReadSSHKeyInput{Fingerprint: fingerprint})
+	if !errors.Is(err, errors.NotFound) {
+		t.Errorf("expected a not found error, got %v", err)
+	}
+}
+
+func TestDeleteSSHKey(t *testing.T) {
+	client := &fakeSSHKeysAPI{keys: []string{testSSHKey1, testSSHKey2}}
+	fingerprint := sshKeyFingerprint(t, testSSHKey1)
+
+	if err := deleteSSHKey(client, &DeleteSSHKeyInput{Fingerprint: fingerprint}); err != nil {
+		t.Fatal(err)
+	}
+	if len(client.deleted) != 1 || client.deleted[0] != fingerprint {
+		t.Errorf("expected key %q to be deleted, got %v", fingerprint, client.deleted)
+	}
+
+	// the last key of the model is kept
+	client = &fakeSSHKeysAPI{keys: []string{testSSHKey1}}
+	if err := deleteSSHKey(client, &DeleteSSHKeyInput{Fingerprint: fingerprint}); err != nil {
+		t.Fatal(err)
+	}
+	if len(client.deleted) != 0 {
+		t.Errorf("expected the last key to be kept, got %v deleted", client.deleted)
+	}
+}
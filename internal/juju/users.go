@@ -1,9 +1,14 @@
 package juju
 
 import (
+	"encoding/asn1"
+	"encoding/base64"
 	"fmt"
+	"sort"
 
 	"github.com/juju/juju/api/client/usermanager"
+	apicontroller "github.com/juju/juju/api/controller/controller"
+	"github.com/juju/juju/jujuclient"
 	"github.com/juju/juju/rpc/params"
 	"github.com/juju/names/v4"
 )
@@ -16,12 +21,18 @@ type CreateUserInput struct {
 	Name        string
 	DisplayName string
 	Model       string
-	Password    string
+	// Password may be empty, the user then registers with the
+	// registration string of the response
+	Password string
+	Disabled bool
 }
 
 type CreateUserResponse struct {
 	UserTag names.UserTag
 	Secret  []byte
+	// RegistrationString is the argument of `juju register` for users
+	// created without a password
+	RegistrationString string
 }
 
 type ReadUserInput struct {
@@ -32,6 +43,16 @@ type ReadUserResponse struct {
 	UserInfo params.UserInfo
 }
 
+type ListUsersInput struct {
+	// Names restricts the users returned, all users are returned when empty
+	Names           []string
+	IncludeDisabled bool
+}
+
+type ListUsersResponse struct {
+	Users []params.UserInfo
+}
+
 type ReadModelUserResponse struct {
 	ModelUserInfo []params.ModelUserInfo
 }
@@ -41,12 +62,29 @@ type UpdateUserInput struct {
 	DisplayName string
 	User        string
 	Password    string
+	// ResetPassword removes the password of the user, who registers
+	// again with the registration string of the response
+	ResetPassword bool
+	// Disabled enables or disables the user, when not nil
+	Disabled *bool
+}
+
+type UpdateUserResponse struct {
+	RegistrationString string
 }
 
 type DestroyUserInput struct {
 	Name string
 }
 
+// userCreationAPI is the part of the UserManager facade used to create
+// users.
+type userCreationAPI interface {
+	AddUser(username, displayName, password string) (names.UserTag, []byte, error)
+	DisableUser(username string) error
+	RemoveUser(username string) error
+}
+
 func newUsersClient(cf ConnectionFactory) *usersClient {
 	return &usersClient{
 		ConnectionFactory: cf,
@@ -62,12 +100,46 @@ func (c *usersClient) CreateUser(input CreateUserInput) (*CreateUserResponse, er
 	client := usermanager.NewClient(conn)
 	defer client.Close()
 
-	userTag, userSecret, err := client.AddUser(input.Name, input.DisplayName, input.Password)
+	userTag, userSecret, err := addUser(client, input)
 	if err != nil {
 		return nil, err
 	}
 
-	return &CreateUserResponse{UserTag: userTag, Secret: userSecret}, nil
+	response := &CreateUserResponse{UserTag: userTag, Secret: userSecret}
+	if input.Password == "" {
+		response.RegistrationString, err = c.registrationString(userTag.Id(), userSecret)
+		if err != nil {
+			return nil, removeAddedUser(client, input.Name, err)
+		}
+	}
+
+	return response, nil
+}
+
+// addUser adds the user, disabled when requested. The user is removed if
+// it cannot be disabled, as it would not be tracked in the state.
+func addUser(client userCreationAPI, input CreateUserInput) (names.UserTag, []byte, error) {
+	userTag, userSecret, err := client.AddUser(input.Name, input.DisplayName, input.Password)
+	if err != nil {
+		return names.UserTag{}, nil, err
+	}
+
+	if input.Disabled {
+		if err := client.DisableUser(input.Name); err != nil {
+			return names.UserTag{}, nil, removeAddedUser(client, input.Name, err)
+		}
+	}
+
+	return userTag, userSecret, nil
+}
+
+// removeAddedUser removes a user whose creation failed with err, and
+// returns err.
+func removeAddedUser(client userCreationAPI, name string, err error) error {
+	if removeErr := client.RemoveUser(name); removeErr != nil {
+		return fmt.Errorf("%s, and user %s could not be removed: %s", err, name, removeErr)
+	}
+	return err
 }
 
 func (c *usersClient) ReadUser(name string) (*ReadUserResponse, error) {
@@ -79,7 +151,7 @@ func (c *usersClient) ReadUser(name string) (*ReadUserResponse, error) {
 	usermanagerClient := usermanager.NewClient(usermanagerConn)
 	defer usermanagerClient.Close()
 
-	users, err := usermanagerClient.UserInfo([]string{name}, false) //don't list disabled users
+	users, err := usermanagerClient.UserInfo([]string{name}, usermanager.AllUsers)
 	if err != nil {
 		return nil, err
 	}
@@ -98,6 +170,37 @@ func (c *usersClient) ReadUser(name string) (*ReadUserResponse, error) {
 	}, nil
 }
 
+// ListUsers returns the users of the controller sorted by their name.
+func (c *usersClient) ListUsers(input ListUsersInput) (*ListUsersResponse, error) {
+	conn, err := c.GetConnection(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	client := usermanager.NewClient(conn)
+	defer client.Close()
+
+	users, err := client.UserInfo(input.Names, usermanager.IncludeDisabled(input.IncludeDisabled))
+	if err != nil {
+		return nil, err
+	}
+	// the controller ignores IncludeDisabled when users are requested by name
+	if !input.IncludeDisabled {
+		enabled := users[:0]
+		for _, user := range users {
+			if !user.Disabled {
+				enabled = append(enabled, user)
+			}
+		}
+		users = enabled
+	}
+	sort.Slice(users, func(i, j int) bool {
+		return users[i].Username < users[j].Username
+	})
+
+	return &ListUsersResponse{Users: users}, nil
+}
+
 func (c *usersClient) ModelUserInfo(uuid string) (*ReadModelUserResponse, error) {
 	usermanagerConn, err := c.GetConnection(nil)
 	if err != nil {
@@ -121,23 +224,46 @@ func (c *usersClient) ModelUserInfo(uuid string) (*ReadModelUserResponse, error)
 	}, nil
 }
 
-func (c *usersClient) UpdateUser(input UpdateUserInput) error {
+// UpdateUser updates the password of the user and enables or disables
+// it. Juju does not support changing the display name of a user.
+func (c *usersClient) UpdateUser(input UpdateUserInput) (*UpdateUserResponse, error) {
 	conn, err := c.GetConnection(nil)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	client := usermanager.NewClient(conn)
 	defer client.Close()
 
+	response := &UpdateUserResponse{}
 	if input.Password != "" {
 		err = client.SetPassword(input.Name, input.Password)
 		if err != nil {
-			return err
+			return nil, err
+		}
+	} else if input.ResetPassword {
+		secret, err := client.ResetPassword(input.Name)
+		if err != nil {
+			return nil, err
+		}
+		response.RegistrationString, err = c.registrationString(input.Name, secret)
+		if err != nil {
+			return nil, err
 		}
 	}
 
-	return nil
+	if input.Disabled != nil {
+		if *input.Disabled {
+			err = client.DisableUser(input.Name)
+		} else {
+			err = client.EnableUser(input.Name)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return response, nil
 }
 
 func (c *usersClient) DestroyUser(input DestroyUserInput) error {
@@ -156,3 +282,42 @@ func (c *usersClient) DestroyUser(input DestroyUserInput) error {
 
 	return nil
 }
+
+// registrationString returns the string given to `juju register` by a
+// user created or reset without a password, like `juju add-user` does.
+func (c *usersClient) registrationString(user string, secret []byte) (string, error) {
+	conn, err := c.GetConnection(nil)
+	if err != nil {
+		return "", err
+	}
+
+	client := apicontroller.NewClient(conn)
+	defer client.Close()
+
+	config, err := client.ControllerConfig()
+	if err != nil {
+		return "", err
+	}
+
+	return encodeRegistrationInfo(jujuclient.RegistrationInfo{
+		User:           user,
+		Addrs:          c.config.ControllerAddresses,
+		SecretKey:      secret,
+		ControllerName: config.ControllerName(),
+	})
+}
+
+// encodeRegistrationInfo marshals the registration info using ASN.1, to
+// keep the size down, and encodes it in base64 padded with zero bytes so
+// the string can be copied and pasted in a terminal.
+func encodeRegistrationInfo(info jujuclient.RegistrationInfo) (string, error) {
+	data, err := asn1.Marshal(info)
+	if err != nil {
+		return "", err
+	}
+	if remainder := len(data) % 3; remainder != 0 {
+		var pad [3]byte
+		data = append(data, pad[:3-remainder]...)
+	}
+	return base64.URLEncoding.EncodeToString(data), nil
+}
@@ -0,0 +1,98 @@
+package juju
+
+import (
+	"encoding/asn1"
+	"encoding/base64"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/juju/juju/jujuclient"
+	"github.com/juju/names/v4"
+)
+
+// fakeUserCreationAPI holds the users of the controller, mapped to
+// whether they are disabled.
+type fakeUserCreationAPI struct {
+	users      map[string]bool
+	disableErr error
+}
+
+func (f *fakeUserCreationAPI) AddUser(username, displayName, password string) (names.UserTag, []byte, error) {
+	f.users[username] = false
+	return names.NewUserTag(username), []byte("secret"), nil
+}
+
+func (f *fakeUserCreationAPI) DisableUser(username string) error {
+	if f.disableErr != nil {
+		return f.disableErr
+	}
+	f.users[username] = true
+	return nil
+}
+
+func (f *fakeUserCreationAPI) RemoveUser(username string) error {
+	delete(f.users, username)
+	return nil
+}
+
+func TestAddUserDisabled(t *testing.T) {
+	client := &fakeUserCreationAPI{users: make(map[string]bool)}
+
+	userTag, _, err := addUser(client, CreateUserInput{Name: "alice", Disabled: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if userTag.Id() != "alice" {
+		t.Errorf("unexpected user %s", userTag.Id())
+	}
+	if disabled, ok := client.users["alice"]; !ok || !disabled {
+		t.Errorf("expected user alice to be disabled, got %v", client.users)
+	}
+}
+
+func TestAddUserDisableFailure(t *testing.T) {
+	client := &fakeUserCreationAPI{
+		users:      make(map[string]bool),
+		disableErr: errors.New("permission denied"),
+	}
+
+	_, _, err := addUser(client, CreateUserInput{Name: "alice", Disabled: true})
+	if err == nil || !strings.Contains(err.Error(), "permission denied") {
+		t.Fatalf("expected the disable error, got %v", err)
+	}
+	if _, ok := client.users["alice"]; ok {
+		t.Errorf("expected user alice to be removed, got %v", client.users)
+	}
+}
+
+func TestEncodeRegistrationInfo(t *testing.T) {
+	info := jujuclient.RegistrationInfo{
+		User:           "alice",
+		Addrs:          []string{"10.0.0.1:17070", "10.0.0.2:17070"},
+		SecretKey:      []byte("0123456789abcdef0123456789abcdef"),
+		ControllerName: "prod",
+	}
+
+	encoded, err := encodeRegistrationInfo(info)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if strings.ContainsAny(encoded, "+/=") {
+		t.Errorf("registration string %q is not terminal friendly", encoded)
+	}
+
+	// decoded as `juju register` does
+	data, err := base64.URLEncoding.DecodeString(encoded)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	var decoded jujuclient.RegistrationInfo
+	if _, err := asn1.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !reflect.DeepEqual(decoded, info) {
+		t.Errorf("expected %+v, got %+v", info, decoded)
+	}
+}
@@ -154,3 +154,14 @@ func WaitForAppsAvailable(ctx context.Context, client *apiapplication.Client, ap
 		}
 	}
 }
+
+// accessLevel returns the index of the access in the levels, ordered
+// from the lowest, or -1 if the access is not one of them.
+func accessLevel(levels []string, access string) int {
+	for i, level := range levels {
+		if level == access {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,76 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/juju/names/v4"
+	"github.com/juju/terraform-provider-juju/internal/juju"
+)
+
+// annotationsAPI is implemented by the annotations client of the
+// controller managing the annotated entity.
+type annotationsAPI interface {
+	GetAnnotations(input juju.GetAnnotationsInput) (*juju.GetAnnotationsResponse, error)
+	SetAnnotations(input juju.SetAnnotationsInput) error
+}
+
+// annotationsSchema returns the schema of the annotations of the
+// entity. Only the annotations set in the plan are managed.
+func annotationsSchema(entity string) *schema.Schema {
+	return &schema.Schema{
+		Description: fmt.Sprintf("Annotations for the %s. Only the annotations set here are managed, other annotations of the %s are left untouched.", entity, entity),
+		Type:        schema.TypeMap,
+		Optional:    true,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+	}
+}
+
+// updateAnnotations applies the changes to the annotations in the plan,
+// unsetting the annotations removed from it.
+func updateAnnotations(client annotationsAPI, d *schema.ResourceData, modelUUID string, tag names.Tag) error {
+	if !d.HasChange("annotations") {
+		return nil
+	}
+
+	oldAnnotations, newAnnotations := d.GetChange("annotations")
+	newAnnotationsMap := newAnnotations.(map[string]interface{})
+	annotations := make(map[string]string, len(newAnnotationsMap))
+	for key, value := range newAnnotationsMap {
+		annotations[key] = value.(string)
+	}
+
+	return client.SetAnnotations(juju.SetAnnotationsInput{
+		ModelUUID:   modelUUID,
+		EntityTag:   tag,
+		Annotations: annotations,
+		Unset:       getUnsetConfigKeys(oldAnnotations.(map[string]interface{}), newAnnotationsMap),
+	})
+}
+
+// readAnnotations reads the annotations tracked in Terraform, so changes
+// made outside Terraform are detected.
+func readAnnotations(client annotationsAPI, d *schema.ResourceData, modelUUID string, tag names.Tag) error {
+	tracked := d.Get("annotations").(map[string]interface{})
+	if len(tracked) == 0 {
+		return nil
+	}
+
+	response, err := client.GetAnnotations(juju.GetAnnotationsInput{
+		ModelUUID: modelUUID,
+		EntityTag: tag,
+	})
+	if err != nil {
+		return err
+	}
+
+	annotations := make(map[string]interface{}, len(tracked))
+	for key := range tracked {
+		if value, exists := response.Annotations[key]; exists {
+			annotations[key] = value
+		}
+	}
+	return d.Set("annotations", annotations)
+}
@@ -0,0 +1,98 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/juju/terraform-provider-juju/internal/juju"
+)
+
+func dataSourceCloud() *schema.Resource {
+	return &schema.Resource{
+		Description: "A data source representing a Juju Cloud existing on the controller.",
+		ReadContext: dataSourceCloudRead,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Description: "The name of the cloud.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"type": {
+				Description: "The type of the cloud.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"auth_types": {
+				Description: "The authentication types supported by the cloud.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"endpoint": {
+				Description: "The default endpoint of the cloud.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"regions": {
+				Description: "The regions of the cloud, the first one being the default region.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Description: "The name of the region.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"endpoint": {
+							Description: "The endpoint of the region.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"identity_endpoint": {
+							Description: "The identity endpoint of the region.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"storage_endpoint": {
+							Description: "The storage endpoint of the region.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceCloudRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*juju.Client)
+
+	response, err := client.Clouds.ReadCloud(juju.ReadCloudInput{
+		Name: d.Get("name").(string),
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloud := response.Cloud
+	d.SetId(cloud.Name)
+	if err = d.Set("type", cloud.Type); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("auth_types", cloudAuthTypes(cloud.AuthTypes)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("endpoint", cloud.Endpoint); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("regions", flattenCloudRegions(cloud.Regions)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
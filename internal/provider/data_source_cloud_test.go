@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAcc_DataSourceCloud(t *testing.T) {
+	cloudName := acctest.RandomWithPrefix("tf-datasource-cloud-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceCloud(cloudName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.juju_cloud.this", "id", cloudName),
+					resource.TestCheckResourceAttr("data.juju_cloud.this", "type", "lxd"),
+					resource.TestCheckResourceAttr("data.juju_cloud.this", "auth_types.#", "1"),
+					resource.TestCheckResourceAttr("data.juju_cloud.this", "auth_types.0", "certificate"),
+					resource.TestCheckResourceAttr("data.juju_cloud.this", "regions.#", "2"),
+					resource.TestCheckResourceAttr("data.juju_cloud.this", "regions.1.name", "second"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceCloud(cloudName string) string {
+	return fmt.Sprintf(`
+resource "juju_cloud" "this" {
+  name       = %q
+  type       = "lxd"
+  auth_types = ["certificate"]
+  endpoint   = "https://10.20.30.40:8443"
+
+  regions {
+    name = "default"
+  }
+
+  regions {
+    name     = "second"
+    endpoint = "https://10.20.30.50:8443"
+  }
+}
+
+data "juju_cloud" "this" {
+  name = juju_cloud.this.name
+}`, cloudName)
+}
@@ -0,0 +1,92 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/juju/terraform-provider-juju/internal/juju"
+)
+
+func dataSourceCredential() *schema.Resource {
+	return &schema.Resource{
+		Description: "A data source representing a controller credential of the current user. Secret attributes are not returned.",
+		ReadContext: dataSourceCredentialRead,
+		Schema: map[string]*schema.Schema{
+			"cloud": {
+				Description: "The name of the cloud of the credential.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"name": {
+				Description: "The name of the credential.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"owner": {
+				Description: "The owner of the credential.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"auth_type": {
+				Description: "The authorization type of the credential.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"valid": {
+				Description: "Whether the controller considers the credential valid.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"attributes": {
+				Description: "The non-secret attributes of the credential.",
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"models": {
+				Description: "The names of the models using the credential.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceCredentialRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*juju.Client)
+
+	response, err := client.Credentials.ReadControllerCredential(juju.ReadControllerCredentialInput{
+		CloudName: d.Get("cloud").(string),
+		Name:      d.Get("name").(string),
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err = d.Set("owner", response.Owner); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("auth_type", response.AuthType); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("valid", response.Valid); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("attributes", response.Attributes); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("models", response.Models); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", response.CloudName, response.Owner, response.Name))
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAcc_DataSourceCredential(t *testing.T) {
+	credentialName := acctest.RandomWithPrefix("tf-datasource-credential-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceCredential(credentialName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.juju_credential.this", "id", fmt.Sprintf("localhost/admin/%s", credentialName)),
+					resource.TestCheckResourceAttr("data.juju_credential.this", "owner", "admin"),
+					resource.TestCheckResourceAttr("data.juju_credential.this", "auth_type", "certificate"),
+					resource.TestCheckResourceAttr("data.juju_credential.this", "models.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceCredential(credentialName string) string {
+	return fmt.Sprintf(`
+resource "juju_credential" "this" {
+  name = %q
+
+  cloud {
+    name = "localhost"
+  }
+
+  auth_type = "certificate"
+
+  attributes = {
+    client-cert = "client-cert"
+    client-key  = "client-key"
+    server-cert = "server-cert"
+  }
+}
+
+data "juju_credential" "this" {
+  cloud = "localhost"
+  name  = juju_credential.this.name
+}`, credentialName)
+}
@@ -0,0 +1,175 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/juju/terraform-provider-juju/internal/juju"
+)
+
+const defaultSensitiveRelationKeys = "(?i)(password|secret|token|key)"
+
+func dataSourceIntegrationData() *schema.Resource {
+	return &schema.Resource{
+		Description: "A data source representing the application and unit relation data of a Juju Integration, as reported by `juju show-unit`.",
+		ReadContext: dataSourceIntegrationDataRead,
+		Schema: map[string]*schema.Schema{
+			"model": {
+				Description: "The name of the model, qualified with its owner as `owner/name` for models owned by other users.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"relation_id": {
+				Description: "The Juju relation id of the integration.",
+				Type:        schema.TypeInt,
+				Required:    true,
+			},
+			"sensitive_keys": {
+				Description:  "A regular expression matching the keys whose values are returned in `sensitive_data` instead of `data`.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      defaultSensitiveRelationKeys,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+			"applications": {
+				Description: "The application data bags of the integration, sorted by application name. The data bag of a remote application is only available when it is integrated with a local unit.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Description: "The name of the application.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"endpoint": {
+							Description: "The endpoint of the application in the integration.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"data":           relationDataSchema(false),
+						"sensitive_data": relationDataSchema(true),
+					},
+				},
+			},
+			"units": {
+				Description: "The unit data bags of the integration, sorted by unit name.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Description: "The name of the unit.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"application": {
+							Description: "The application of the unit.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"in_scope": {
+							Description: "Whether the unit has entered the scope of the integration.",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+						"data":           relationDataSchema(false),
+						"sensitive_data": relationDataSchema(true),
+					},
+				},
+			},
+		},
+	}
+}
+
+func relationDataSchema(sensitive bool) *schema.Schema {
+	description := "The relation data whose keys do not match `sensitive_keys`."
+	if sensitive {
+		description = "The relation data whose keys match `sensitive_keys`."
+	}
+	return &schema.Schema{
+		Description: description,
+		Type:        schema.TypeMap,
+		Computed:    true,
+		Sensitive:   sensitive,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+	}
+}
+
+func dataSourceIntegrationDataRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*juju.Client)
+
+	modelName := d.Get("model").(string)
+	relationID := d.Get("relation_id").(int)
+	sensitiveKeys, err := regexp.Compile(d.Get("sensitive_keys").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	modelUUID, err := client.Models.ResolveModelUUID(modelName)
+	if err != nil {
+		return checkModelErr(err)
+	}
+
+	response, err := client.Integrations.ReadIntegrationData(&juju.ReadIntegrationDataInput{
+		ModelUUID:  modelUUID,
+		RelationID: relationID,
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	applications := make([]map[string]interface{}, 0, len(response.Applications))
+	for _, application := range response.Applications {
+		data, sensitiveData := splitRelationData(application.Data, sensitiveKeys)
+		applications = append(applications, map[string]interface{}{
+			"name":           application.Name,
+			"endpoint":       application.Endpoint,
+			"data":           data,
+			"sensitive_data": sensitiveData,
+		})
+	}
+
+	units := make([]map[string]interface{}, 0, len(response.Units))
+	for _, unit := range response.Units {
+		data, sensitiveData := splitRelationData(unit.Data, sensitiveKeys)
+		units = append(units, map[string]interface{}{
+			"name":           unit.Name,
+			"application":    unit.Application,
+			"in_scope":       unit.InScope,
+			"data":           data,
+			"sensitive_data": sensitiveData,
+		})
+	}
+
+	d.SetId(fmt.Sprintf("%s:%d", modelUUID, relationID))
+	if err = d.Set("applications", applications); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("units", units); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// splitRelationData splits the relation data between the values of the
+// keys matching sensitiveKeys and the rest.
+func splitRelationData(values map[string]string, sensitiveKeys *regexp.Regexp) (map[string]interface{}, map[string]interface{}) {
+	data := make(map[string]interface{})
+	sensitiveData := make(map[string]interface{})
+	for key, value := range values {
+		if sensitiveKeys.MatchString(key) {
+			sensitiveData[key] = value
+		} else {
+			data[key] = value
+		}
+	}
+	return data, sensitiveData
+}
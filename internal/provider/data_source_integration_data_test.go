@@ -0,0 +1,41 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAcc_DataSourceIntegrationData(t *testing.T) {
+	modelName := acctest.RandomWithPrefix("tf-datasource-integration-data-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceIntegrationData(modelName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.juju_integration_data.this", "relation_id", "juju_integration.this", "relation_id"),
+					resource.TestCheckResourceAttr("data.juju_integration_data.this", "applications.#", "2"),
+					resource.TestCheckResourceAttr("data.juju_integration_data.this", "applications.0.name", "one"),
+					resource.TestCheckResourceAttr("data.juju_integration_data.this", "applications.1.name", "two"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.juju_integration_data.this", "units.*", map[string]string{
+						"name":     "one/0",
+						"in_scope": "true",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceIntegrationData(modelName string) string {
+	return testAccResourceIntegration(modelName, "two") + `
+data "juju_integration_data" "this" {
+	model       = juju_model.this.name
+	relation_id = juju_integration.this.relation_id
+}
+`
+}
@@ -14,7 +14,7 @@ func dataSourceMachine() *schema.Resource {
 		ReadContext: dataSourceMachineRead,
 		Schema: map[string]*schema.Schema{
 			"model": {
-				Description: "The name of the model.",
+				Description: "The name of the model, qualified with its owner as `owner/name` for models owned by other users.",
 				Type:        schema.TypeString,
 				Required:    true,
 			},
@@ -35,7 +35,7 @@ func dataSourceMachineRead(ctx context.Context, d *schema.ResourceData, meta int
 
 	model, err := client.Models.GetModelByName(modelName)
 	if err != nil {
-		return diag.FromErr(err)
+		return checkModelErr(err)
 	}
 
 	machine, err := client.Machines.ReadMachine(&juju.ReadMachineInput{
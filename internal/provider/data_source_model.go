@@ -14,7 +14,7 @@ func dataSourceModel() *schema.Resource {
 		ReadContext: dataSourceModelRead,
 		Schema: map[string]*schema.Schema{
 			"name": {
-				Description: "The name of the model.",
+				Description: "The name of the model, qualified with its owner as `owner/name` for models owned by other users.",
 				Type:        schema.TypeString,
 				Required:    true,
 			},
@@ -34,7 +34,7 @@ func dataSourceModelRead(ctx context.Context, d *schema.ResourceData, meta inter
 
 	model, err := client.Models.GetModelByName(modelName)
 	if err != nil {
-		return diag.FromErr(err)
+		return checkModelErr(err)
 	}
 
 	d.SetId(model.UUID)
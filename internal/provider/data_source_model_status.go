@@ -0,0 +1,425 @@
+package provider
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/juju/juju/rpc/params"
+	"github.com/juju/terraform-provider-juju/internal/juju"
+)
+
+func dataSourceModelStatus() *schema.Resource {
+	return &schema.Resource{
+		Description: "A data source representing the status of a Juju Model, as reported by `juju status`.",
+		ReadContext: dataSourceModelStatusRead,
+		Schema: map[string]*schema.Schema{
+			"model": {
+				Description: "The name of the model, qualified with its owner as `owner/name` for models owned by other users.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"filter": {
+				Description: "Patterns filtering the status, as accepted by `juju status`. For example application, unit or machine names, or workload statuses like `blocked`.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"status": {
+				Description: "The status of the model.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"applications": {
+				Description: "The applications in the model, sorted by name.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Description: "The name of the application.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"charm": {
+							Description: "The charm URL of the application.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"status": {
+							Description: "The workload status of the application.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"message": {
+							Description: "The workload status message of the application.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"exposed": {
+							Description: "Whether the application is exposed.",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+						"life": {
+							Description: "The life of the application.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"units": {
+							Description: "The units of the application, including subordinate units, sorted by name.",
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Description: "The name of the unit.",
+										Type:        schema.TypeString,
+										Computed:    true,
+									},
+									"machine": {
+										Description: "The machine hosting the unit.",
+										Type:        schema.TypeString,
+										Computed:    true,
+									},
+									"workload_status": {
+										Description: "The workload status of the unit.",
+										Type:        schema.TypeString,
+										Computed:    true,
+									},
+									"workload_message": {
+										Description: "The workload status message of the unit.",
+										Type:        schema.TypeString,
+										Computed:    true,
+									},
+									"agent_status": {
+										Description: "The status of the unit agent.",
+										Type:        schema.TypeString,
+										Computed:    true,
+									},
+									"leader": {
+										Description: "Whether the unit is the leader of its application.",
+										Type:        schema.TypeBool,
+										Computed:    true,
+									},
+									"public_address": {
+										Description: "The public address of the unit.",
+										Type:        schema.TypeString,
+										Computed:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"machines": {
+				Description: "The machines in the model, sorted by id. Containers follow their host machine.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"machine_id": {
+							Description: "The Juju id of the machine.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"instance_id": {
+							Description: "The id of the machine instance in the cloud.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"status": {
+							Description: "The status of the machine agent.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"instance_status": {
+							Description: "The status of the machine instance in the cloud.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"dns_name": {
+							Description: "The DNS name of the machine.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"series": {
+							Description: "The series installed on the machine.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"relations": {
+				Description: "The relations in the model, sorted by id.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "The id of the relation.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"key": {
+							Description: "The key of the relation, listing its endpoints.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"interface": {
+							Description: "The interface of the relation.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"status": {
+							Description: "The status of the relation.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"remote_applications": {
+				Description: "The applications consumed from other models, sorted by name.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Description: "The name of the remote application in the model.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"offer_url": {
+							Description: "The URL of the consumed offer.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"status": {
+							Description: "The status of the remote application.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"life": {
+							Description: "The life of the remote application.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"offers": {
+				Description: "The offers made from the model, sorted by name.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Description: "The name of the offer.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"application": {
+							Description: "The name of the offered application.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"endpoints": {
+							Description: "The offered endpoints.",
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"active_connections": {
+							Description: "The number of active connections to the offer.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"total_connections": {
+							Description: "The total number of connections to the offer.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceModelStatusRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*juju.Client)
+
+	modelName := d.Get("model").(string)
+	patterns := []string{}
+	for _, pattern := range d.Get("filter").([]interface{}) {
+		patterns = append(patterns, pattern.(string))
+	}
+
+	modelUUID, err := client.Models.ResolveModelUUID(modelName)
+	if err != nil {
+		return checkModelErr(err)
+	}
+
+	response, err := client.Models.ReadModelStatus(juju.ReadModelStatusInput{
+		ModelUUID: modelUUID,
+		Patterns:  patterns,
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	status := response.Status
+
+	d.SetId(strings.Join(append([]string{modelUUID}, patterns...), ":"))
+	if err = d.Set("status", status.Model.ModelStatus.Status); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("applications", flattenApplicationsStatus(status.Applications)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("machines", flattenMachinesStatus(status.Machines)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("relations", flattenRelationsStatus(status.Relations)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("remote_applications", flattenRemoteApplicationsStatus(status.RemoteApplications)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("offers", flattenOffersStatus(status.Offers)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func flattenApplicationsStatus(applications map[string]params.ApplicationStatus) []map[string]interface{} {
+	// Subordinate units are only reported under their principal unit,
+	// collect them for their own application.
+	units := make(map[string][]map[string]interface{})
+	var collectUnit func(name string, unit params.UnitStatus, machine string)
+	collectUnit = func(name string, unit params.UnitStatus, machine string) {
+		if unit.Machine != "" {
+			machine = unit.Machine
+		}
+		application := strings.Split(name, "/")[0]
+		units[application] = append(units[application], map[string]interface{}{
+			"name":             name,
+			"machine":          machine,
+			"workload_status":  unit.WorkloadStatus.Status,
+			"workload_message": unit.WorkloadStatus.Info,
+			"agent_status":     unit.AgentStatus.Status,
+			"leader":           unit.Leader,
+			"public_address":   unit.PublicAddress,
+		})
+		for _, subordinateName := range sortedKeys(unit.Subordinates) {
+			collectUnit(subordinateName, unit.Subordinates[subordinateName], machine)
+		}
+	}
+	for _, name := range sortedKeys(applications) {
+		for _, unitName := range sortedKeys(applications[name].Units) {
+			collectUnit(unitName, applications[name].Units[unitName], "")
+		}
+	}
+
+	result := make([]map[string]interface{}, 0, len(applications))
+	for _, name := range sortedKeys(applications) {
+		application := applications[name]
+		applicationUnits := units[name]
+		sort.Slice(applicationUnits, func(i, j int) bool {
+			return applicationUnits[i]["name"].(string) < applicationUnits[j]["name"].(string)
+		})
+		result = append(result, map[string]interface{}{
+			"name":    name,
+			"charm":   application.Charm,
+			"status":  application.Status.Status,
+			"message": application.Status.Info,
+			"exposed": application.Exposed,
+			"life":    string(application.Life),
+			"units":   applicationUnits,
+		})
+	}
+	return result
+}
+
+func flattenMachinesStatus(machines map[string]params.MachineStatus) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(machines))
+	for _, id := range sortedKeys(machines) {
+		machine := machines[id]
+		result = append(result, map[string]interface{}{
+			"machine_id":      id,
+			"instance_id":     string(machine.InstanceId),
+			"status":          machine.AgentStatus.Status,
+			"instance_status": machine.InstanceStatus.Status,
+			"dns_name":        machine.DNSName,
+			"series":          machine.Series,
+		})
+		// Containers are reported under their host machine.
+		result = append(result, flattenMachinesStatus(machine.Containers)...)
+	}
+	return result
+}
+
+func flattenRelationsStatus(relations []params.RelationStatus) []map[string]interface{} {
+	sorted := append([]params.RelationStatus{}, relations...)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Id < sorted[j].Id
+	})
+
+	result := make([]map[string]interface{}, 0, len(sorted))
+	for _, relation := range sorted {
+		result = append(result, map[string]interface{}{
+			"id":        relation.Id,
+			"key":       relation.Key,
+			"interface": relation.Interface,
+			"status":    relation.Status.Status,
+		})
+	}
+	return result
+}
+
+func flattenRemoteApplicationsStatus(remoteApplications map[string]params.RemoteApplicationStatus) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(remoteApplications))
+	for _, name := range sortedKeys(remoteApplications) {
+		remoteApplication := remoteApplications[name]
+		result = append(result, map[string]interface{}{
+			"name":      name,
+			"offer_url": remoteApplication.OfferURL,
+			"status":    remoteApplication.Status.Status,
+			"life":      string(remoteApplication.Life),
+		})
+	}
+	return result
+}
+
+func flattenOffersStatus(offers map[string]params.ApplicationOfferStatus) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(offers))
+	for _, name := range sortedKeys(offers) {
+		offer := offers[name]
+		result = append(result, map[string]interface{}{
+			"name":               name,
+			"application":        offer.ApplicationName,
+			"endpoints":          sortedKeys(offer.Endpoints),
+			"active_connections": offer.ActiveConnectedCount,
+			"total_connections":  offer.TotalConnectedCount,
+		})
+	}
+	return result
+}
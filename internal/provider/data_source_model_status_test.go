@@ -0,0 +1,51 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAcc_DataSourceModelStatus(t *testing.T) {
+	modelName := acctest.RandomWithPrefix("tf-datasource-model-status-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceModelStatus(t, modelName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.juju_model_status.status", "status", "available"),
+					resource.TestCheckResourceAttr("data.juju_model_status.status", "applications.#", "1"),
+					resource.TestCheckResourceAttr("data.juju_model_status.status", "applications.0.name", "test-app"),
+					resource.TestCheckResourceAttr("data.juju_model_status.status", "applications.0.units.#", "1"),
+					resource.TestCheckResourceAttr("data.juju_model_status.status", "applications.0.units.0.name", "test-app/0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceModelStatus(t *testing.T, modelName string) string {
+	return fmt.Sprintf(`
+resource "juju_model" "model" {
+	name = %q
+}
+
+resource "juju_application" "app" {
+  model = juju_model.model.name
+  name  = "test-app"
+
+  charm {
+    name = "ubuntu"
+  }
+}
+
+data "juju_model_status" "status" {
+  model  = juju_model.model.name
+  filter = [juju_application.app.name]
+}`, modelName)
+}
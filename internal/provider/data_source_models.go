@@ -0,0 +1,162 @@
+package provider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/juju/juju/api/base"
+	"github.com/juju/terraform-provider-juju/internal/juju"
+)
+
+func dataSourceModels() *schema.Resource {
+	return &schema.Resource{
+		Description: "A data source representing the Juju Models visible to the current user.",
+		ReadContext: dataSourceModelsRead,
+		Schema: map[string]*schema.Schema{
+			"owner": {
+				Description: "Only return models owned by this user.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"cloud": {
+				Description: "Only return models hosted on this cloud.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"type": {
+				Description:  "Only return models of this type. Valid values are `iaas` and `caas`.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"iaas", "caas"}, false),
+			},
+			"models": {
+				Description: "The models matching the filters, sorted by their qualified name.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Description: "The name of the model.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"qualified_name": {
+							Description: "The name of the model qualified with its owner as `owner/name`.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"uuid": {
+							Description: "The UUID of the model.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"owner": {
+							Description: "The owner of the model.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"cloud": {
+							Description: "The cloud hosting the model.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"region": {
+							Description: "The cloud region hosting the model.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"type": {
+							Description: "The type of the model.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"status": {
+							Description: "The status of the model.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"life": {
+							Description: "The life of the model.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"machine_count": {
+							Description: "The number of machines in the model.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"unit_count": {
+							Description: "The number of units in the model.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"last_connection": {
+							Description: "The last time the current user connected to the model, in RFC 3339 format. Empty if the user never connected.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceModelsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*juju.Client)
+
+	owner := d.Get("owner").(string)
+	cloud := d.Get("cloud").(string)
+	modelType := d.Get("type").(string)
+
+	response, err := client.Models.ListModels(juju.ListModelsInput{
+		Owner: owner,
+		Cloud: cloud,
+		Type:  modelType,
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	models := make([]map[string]interface{}, 0, len(response.Models))
+	for _, summary := range response.Models {
+		models = append(models, flattenModelSummary(summary))
+	}
+
+	d.SetId(strings.Join([]string{owner, cloud, modelType}, ":"))
+	if err = d.Set("models", models); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func flattenModelSummary(summary base.UserModelSummary) map[string]interface{} {
+	var machines, units int64
+	for _, count := range summary.Counts {
+		switch count.Entity {
+		case "machines":
+			machines = count.Count
+		case "units":
+			units = count.Count
+		}
+	}
+
+	return map[string]interface{}{
+		"name":            summary.Name,
+		"qualified_name":  summary.Owner + "/" + summary.Name,
+		"uuid":            summary.UUID,
+		"owner":           summary.Owner,
+		"cloud":           summary.Cloud,
+		"region":          summary.CloudRegion,
+		"type":            summary.Type.String(),
+		"status":          string(summary.Status.Status),
+		"life":            string(summary.Life),
+		"machine_count":   int(machines),
+		"unit_count":      int(units),
+		"last_connection": formatLastConnection(summary.UserLastConnection),
+	}
+}
@@ -0,0 +1,45 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAcc_DataSourceModels(t *testing.T) {
+	modelName := acctest.RandomWithPrefix("tf-datasource-models-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceModels(t, modelName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("data.juju_models.models", "models.*", map[string]string{
+						"name":  modelName,
+						"owner": "admin",
+						"type":  "iaas",
+						"life":  "alive",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceModels(t *testing.T, modelName string) string {
+	return fmt.Sprintf(`
+resource "juju_model" "model" {
+	name = %q
+}
+
+data "juju_models" "models" {
+  owner = "admin"
+  type  = "iaas"
+
+  depends_on = [juju_model.model]
+}`, modelName)
+}
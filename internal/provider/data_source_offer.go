@@ -34,7 +34,21 @@ func dataSourceOffer() *schema.Resource {
 				Computed:    true,
 			},
 			"endpoint": {
-				Description: "The endpoint name.",
+				Description: "The first endpoint name.",
+				Type:        schema.TypeString,
+				Computed:    true,
+				Deprecated:  "Use `endpoints` instead.",
+			},
+			"endpoints": {
+				Description: "The endpoint names.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"description": {
+				Description: "The description of the offer.",
 				Type:        schema.TypeString,
 				Computed:    true,
 			},
@@ -68,7 +82,17 @@ func dataSourceOfferRead(ctx context.Context, d *schema.ResourceData, meta inter
 	if err = d.Set("application_name", offer.ApplicationName); err != nil {
 		return diag.FromErr(err)
 	}
-	if err = d.Set("endpoint", offer.Endpoint); err != nil {
+	endpoint := ""
+	if len(offer.Endpoints) > 0 {
+		endpoint = offer.Endpoints[0]
+	}
+	if err = d.Set("endpoint", endpoint); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("endpoints", offer.Endpoints); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("description", offer.Description); err != nil {
 		return diag.FromErr(err)
 	}
 
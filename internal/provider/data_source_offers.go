@@ -0,0 +1,160 @@
+package provider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/juju/terraform-provider-juju/internal/juju"
+)
+
+func dataSourceOffers() *schema.Resource {
+	return &schema.Resource{
+		Description: "A data source representing the Juju Offers visible to the current user, across models.",
+		ReadContext: dataSourceOffersRead,
+		Schema: map[string]*schema.Schema{
+			"owner": {
+				Description: "Only return offers of models owned by this user.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"model": {
+				Description: "Only return offers of models with this name.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"application": {
+				Description: "Only return offers of this application.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"interface": {
+				Description: "Only return offers with an endpoint of this interface.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"role": {
+				Description:  "Only return offers with an endpoint of this role. Valid values are `provider`, `requirer` and `peer`.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"provider", "requirer", "peer"}, false),
+			},
+			"allowed_consumer": {
+				Description: "Only return offers this user is allowed to consume.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"offers": {
+				Description: "The offers matching the filters, sorted by their URL.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Description: "The name of the offer.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"url": {
+							Description: "The offer URL.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"application_name": {
+							Description: "The name of the offered application.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"description": {
+							Description: "The description of the offer.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"endpoints": {
+							Description: "The endpoints of the offer.",
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Description: "The name of the endpoint.",
+										Type:        schema.TypeString,
+										Computed:    true,
+									},
+									"interface": {
+										Description: "The interface of the endpoint.",
+										Type:        schema.TypeString,
+										Computed:    true,
+									},
+									"role": {
+										Description: "The role of the endpoint.",
+										Type:        schema.TypeString,
+										Computed:    true,
+									},
+								},
+							},
+						},
+						"connection_count": {
+							Description: "The number of connections to the offer.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"access": {
+							Description: "The access of the current user to the offer.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceOffersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*juju.Client)
+
+	input := juju.FindOffersInput{
+		Owner:           d.Get("owner").(string),
+		ModelName:       d.Get("model").(string),
+		Application:     d.Get("application").(string),
+		Interface:       d.Get("interface").(string),
+		Role:            d.Get("role").(string),
+		AllowedConsumer: d.Get("allowed_consumer").(string),
+	}
+
+	response, err := client.Offers.FindOffers(&input)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	offers := make([]map[string]interface{}, 0, len(response.Offers))
+	for _, offer := range response.Offers {
+		endpoints := make([]map[string]interface{}, 0, len(offer.Endpoints))
+		for _, endpoint := range offer.Endpoints {
+			endpoints = append(endpoints, map[string]interface{}{
+				"name":      endpoint.Name,
+				"interface": endpoint.Interface,
+				"role":      endpoint.Role,
+			})
+		}
+		offers = append(offers, map[string]interface{}{
+			"name":             offer.Name,
+			"url":              offer.OfferURL,
+			"application_name": offer.ApplicationName,
+			"description":      offer.Description,
+			"endpoints":        endpoints,
+			"connection_count": offer.ConnectionCount,
+			"access":           offer.Access,
+		})
+	}
+
+	d.SetId(strings.Join([]string{input.Owner, input.ModelName, input.Application, input.Interface, input.Role, input.AllowedConsumer}, ":"))
+	if err = d.Set("offers", offers); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,65 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAcc_DataSourceOffers(t *testing.T) {
+	modelName := acctest.RandomWithPrefix("tf-datasource-offers-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceOffers(modelName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.juju_offers.this", "offers.#", "1"),
+					resource.TestCheckResourceAttr("data.juju_offers.this", "offers.0.url", fmt.Sprintf("admin/%s.this", modelName)),
+					resource.TestCheckResourceAttr("data.juju_offers.this", "offers.0.application_name", "this"),
+					resource.TestCheckResourceAttr("data.juju_offers.this", "offers.0.access", "admin"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.juju_offers.this", "offers.0.endpoints.*", map[string]string{
+						"name":      "db",
+						"interface": "pgsql",
+						"role":      "provider",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceOffers(modelName string) string {
+	return fmt.Sprintf(`
+resource "juju_model" "this" {
+	name = %q
+}
+
+resource "juju_application" "this" {
+	model = juju_model.this.name
+	name  = "this"
+
+	charm {
+		name = "postgresql"
+		series = "focal"
+	}
+}
+
+resource "juju_offer" "this" {
+	model            = juju_model.this.name
+	application_name = juju_application.this.name
+	endpoints        = ["db"]
+}
+
+data "juju_offers" "this" {
+	model       = juju_model.this.name
+	application = juju_application.this.name
+	interface   = "pgsql"
+
+	depends_on = [juju_offer.this]
+}`, modelName)
+}
@@ -0,0 +1,216 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/juju/juju/rpc/params"
+	"github.com/juju/terraform-provider-juju/internal/juju"
+)
+
+func dataSourceUsers() *schema.Resource {
+	return &schema.Resource{
+		Description: "A data source representing the Juju Users of the controller.",
+		ReadContext: dataSourceUsersRead,
+		Schema: map[string]*schema.Schema{
+			"names": {
+				Description: "Only return these users.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"include_disabled": {
+				Description: "Also return the disabled users.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"include_model_access": {
+				Description: "Also return the access of the users to the models visible to the current user.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"users": {
+				Description: "The users, sorted by their name.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Description: "The name of the user.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"display_name": {
+							Description: "The display name of the user.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"access": {
+							Description: "The access of the user to the controller.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"created_by": {
+							Description: "The user who created the user.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"date_created": {
+							Description: "The date the user was created, in RFC 3339 format.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"last_connection": {
+							Description: "The last time the user connected to the controller, in RFC 3339 format. Empty if the user never connected.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"disabled": {
+							Description: "Whether the user is disabled.",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+						"model_access": {
+							Description: "The access of the user to the models, sorted by model, when `include_model_access` is set.",
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"model": {
+										Description: "The name of the model qualified with its owner as `owner/name`.",
+										Type:        schema.TypeString,
+										Computed:    true,
+									},
+									"uuid": {
+										Description: "The UUID of the model.",
+										Type:        schema.TypeString,
+										Computed:    true,
+									},
+									"access": {
+										Description: "The access of the user to the model.",
+										Type:        schema.TypeString,
+										Computed:    true,
+									},
+									"last_connection": {
+										Description: "The last time the user connected to the model, in RFC 3339 format. Empty if the user never connected.",
+										Type:        schema.TypeString,
+										Computed:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceUsersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*juju.Client)
+
+	var names []string
+	for _, name := range d.Get("names").([]interface{}) {
+		names = append(names, name.(string))
+	}
+	includeDisabled := d.Get("include_disabled").(bool)
+	includeModelAccess := d.Get("include_model_access").(bool)
+
+	response, err := client.Users.ListUsers(juju.ListUsersInput{
+		Names:           names,
+		IncludeDisabled: includeDisabled,
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var modelAccess map[string][]map[string]interface{}
+	if includeModelAccess {
+		modelAccess, err = usersModelAccess(client)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	users := make([]map[string]interface{}, 0, len(response.Users))
+	for _, user := range response.Users {
+		flattened := flattenUserInfo(user)
+		if includeModelAccess {
+			access := modelAccess[user.Username]
+			if access == nil {
+				access = []map[string]interface{}{}
+			}
+			flattened["model_access"] = access
+		}
+		users = append(users, flattened)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%t:%t", strings.Join(names, ","), includeDisabled, includeModelAccess))
+	if err = d.Set("users", users); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// usersModelAccess returns the access of the users to the models visible
+// to the current user, by user name.
+func usersModelAccess(client *juju.Client) (map[string][]map[string]interface{}, error) {
+	models, err := client.Models.ListModels(juju.ListModelsInput{})
+	if err != nil {
+		return nil, err
+	}
+
+	access := make(map[string][]map[string]interface{})
+	// the models are sorted by their qualified name
+	for _, model := range models.Models {
+		response, err := client.Users.ModelUserInfo(model.UUID)
+		if err != nil {
+			return nil, err
+		}
+		modelUsers := response.ModelUserInfo
+		sort.Slice(modelUsers, func(i, j int) bool {
+			return modelUsers[i].UserName < modelUsers[j].UserName
+		})
+		for _, modelUser := range modelUsers {
+			access[modelUser.UserName] = append(access[modelUser.UserName], map[string]interface{}{
+				"model":           model.Owner + "/" + model.Name,
+				"uuid":            model.UUID,
+				"access":          string(modelUser.Access),
+				"last_connection": formatLastConnection(modelUser.LastConnection),
+			})
+		}
+	}
+	return access, nil
+}
+
+func flattenUserInfo(user params.UserInfo) map[string]interface{} {
+	return map[string]interface{}{
+		"name":            user.Username,
+		"display_name":    user.DisplayName,
+		"access":          user.Access,
+		"created_by":      user.CreatedBy,
+		"date_created":    user.DateCreated.UTC().Format(time.RFC3339),
+		"last_connection": formatLastConnection(user.LastConnection),
+		"disabled":        user.Disabled,
+		"model_access":    []map[string]interface{}{},
+	}
+}
+
+// formatLastConnection formats a last connection time in RFC 3339, or
+// returns an empty string when there was no connection.
+func formatLastConnection(lastConnection *time.Time) string {
+	if lastConnection == nil {
+		return ""
+	}
+	return lastConnection.UTC().Format(time.RFC3339)
+}
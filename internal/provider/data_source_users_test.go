@@ -0,0 +1,77 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAcc_DataSourceUsers(t *testing.T) {
+	userName := acctest.RandomWithPrefix("tfuser")
+	disabledUserName := acctest.RandomWithPrefix("tfuser")
+	modelName := acctest.RandomWithPrefix("tf-datasource-users-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceUsers(userName, disabledUserName, modelName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.juju_users.active", "users.#", "1"),
+					resource.TestCheckResourceAttr("data.juju_users.active", "users.0.name", userName),
+					resource.TestCheckResourceAttr("data.juju_users.active", "users.0.display_name", "Test User"),
+					resource.TestCheckResourceAttr("data.juju_users.active", "users.0.access", "login"),
+					resource.TestCheckResourceAttr("data.juju_users.active", "users.0.created_by", "admin"),
+					resource.TestCheckResourceAttr("data.juju_users.active", "users.0.last_connection", ""),
+					resource.TestCheckResourceAttr("data.juju_users.active", "users.0.disabled", "false"),
+					resource.TestCheckResourceAttr("data.juju_users.active", "users.0.model_access.#", "1"),
+					resource.TestCheckResourceAttr("data.juju_users.active", "users.0.model_access.0.model", "admin/"+modelName),
+					resource.TestCheckResourceAttr("data.juju_users.active", "users.0.model_access.0.access", "read"),
+					resource.TestCheckResourceAttr("data.juju_users.all", "users.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.juju_users.all", "users.*", map[string]string{
+						"name":     disabledUserName,
+						"disabled": "true",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceUsers(userName, disabledUserName, modelName string) string {
+	return fmt.Sprintf(`
+resource "juju_user" "active" {
+  name         = %q
+  display_name = "Test User"
+}
+
+resource "juju_user" "disabled" {
+  name     = %q
+  disabled = true
+}
+
+resource "juju_model" "this" {
+  name = %q
+}
+
+resource "juju_access_model" "this" {
+  model  = juju_model.this.name
+  access = "read"
+  users  = [juju_user.active.name]
+}
+
+data "juju_users" "active" {
+  names                = [juju_user.active.name, juju_user.disabled.name]
+  include_model_access = true
+
+  depends_on = [juju_access_model.this]
+}
+
+data "juju_users" "all" {
+  names            = [juju_user.active.name, juju_user.disabled.name]
+  include_disabled = true
+}`, userName, disabledUserName, modelName)
+}
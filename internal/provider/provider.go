@@ -8,6 +8,7 @@ import (
 	"net"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -17,10 +18,11 @@ import (
 )
 
 const (
-	JujuControllerEnvKey = "JUJU_CONTROLLER_ADDRESSES"
-	JujuUsernameEnvKey   = "JUJU_USERNAME"
-	JujuPasswordEnvKey   = "JUJU_PASSWORD"
-	JujuCACertEnvKey     = "JUJU_CA_CERT"
+	JujuControllerEnvKey            = "JUJU_CONTROLLER_ADDRESSES"
+	JujuUsernameEnvKey              = "JUJU_USERNAME"
+	JujuPasswordEnvKey              = "JUJU_PASSWORD"
+	JujuCACertEnvKey                = "JUJU_CA_CERT"
+	JujuClientCredentialStoreEnvKey = "JUJU_CLIENT_CREDENTIAL_STORE"
 )
 
 func New(version string) func() *schema.Provider {
@@ -52,22 +54,43 @@ func New(version string) func() *schema.Provider {
 					Optional:    true,
 					DefaultFunc: getProviderConfigFunc(JujuCACertEnvKey),
 				},
+				"client_credential_store": {
+					Type:        schema.TypeString,
+					Description: fmt.Sprintf("This is where the credentials created with `client_credential` are stored: a directory holding a `credentials.yaml` file, or `%s` to only keep them in memory for the run, in which case they are not read back by later runs. Defaults to the data directory of the local Juju client. This can also be set by the `%s` environment variable", juju.ClientStoreMemory, JujuClientCredentialStoreEnvKey),
+					Optional:    true,
+					DefaultFunc: getProviderConfigFunc(JujuClientCredentialStoreEnvKey),
+				},
 			},
 			DataSourcesMap: map[string]*schema.Resource{
-				"juju_model":   dataSourceModel(),
-				"juju_machine": dataSourceMachine(),
-				"juju_offer":   dataSourceOffer(),
+				"juju_cloud":            dataSourceCloud(),
+				"juju_credential":       dataSourceCredential(),
+				"juju_integration_data": dataSourceIntegrationData(),
+				"juju_model":            dataSourceModel(),
+				"juju_models":           dataSourceModels(),
+				"juju_model_status":     dataSourceModelStatus(),
+				"juju_machine":          dataSourceMachine(),
+				"juju_offer":            dataSourceOffer(),
+				"juju_offers":           dataSourceOffers(),
+				"juju_users":            dataSourceUsers(),
 			},
 			ResourcesMap: map[string]*schema.Resource{
-				"juju_application":  resourceApplication(),
-				"juju_access_model": resourceAccessModel(),
-				"juju_credential":   resourceCredential(),
-				"juju_integration":  resourceIntegration(),
-				"juju_model":        resourceModel(),
-				"juju_offer":        resourceOffer(),
-				"juju_machine":      resourceMachine(),
-				"juju_ssh_key":      resourceSSHKey(),
-				"juju_user":         resourceUser(),
+				"juju_application":       resourceApplication(),
+				"juju_access_cloud":      resourceAccessCloud(),
+				"juju_access_controller": resourceAccessController(),
+				"juju_access_model":      resourceAccessModel(),
+				"juju_access_offer":      resourceAccessOffer(),
+				"juju_cloud":             resourceCloud(),
+				"juju_controller_config": resourceControllerConfig(),
+				"juju_credential":        resourceCredential(),
+				"juju_integration":       resourceIntegration(),
+				"juju_kubernetes_cloud":  resourceKubernetesCloud(),
+				"juju_model":             resourceModel(),
+				"juju_model_defaults":    resourceModelDefaults(),
+				"juju_offer":             resourceOffer(),
+				"juju_saas":              resourceSAAS(),
+				"juju_machine":           resourceMachine(),
+				"juju_ssh_key":           resourceSSHKey(),
+				"juju_user":              resourceUser(),
 			},
 		}
 
@@ -108,6 +131,7 @@ func configure(version string, p *schema.Provider) func(context.Context, *schema
 		username := d.Get("username").(string)
 		password := d.Get("password").(string)
 		caCert := d.Get("ca_certificate").(string)
+		clientCredentialStore := d.Get("client_credential_store").(string)
 
 		//TODO: remove this check when other auth methods are added
 		if username == "" || password == "" {
@@ -120,10 +144,11 @@ func configure(version string, p *schema.Provider) func(context.Context, *schema
 		}
 
 		config := juju.Configuration{
-			ControllerAddresses: ControllerAddresses,
-			Username:            username,
-			Password:            password,
-			CACert:              caCert,
+			ControllerAddresses:   ControllerAddresses,
+			Username:              username,
+			Password:              password,
+			CACert:                caCert,
+			ClientCredentialStore: clientCredentialStore,
 		}
 		client, err := juju.NewClient(config)
 		if err != nil {
@@ -169,3 +194,39 @@ func checkClientErr(err error, diags diag.Diagnostics, config juju.Configuration
 	}
 	return diag.FromErr(err)
 }
+
+// checkModelErr returns the diagnostics for an error resolving a model
+// reference, suggesting the models that may have been meant.
+func checkModelErr(err error) diag.Diagnostics {
+	var notFoundError *juju.ModelNotFoundError
+	if errors.As(err, &notFoundError) {
+		errDetail := "Verify the model name. Models owned by other users must be referenced as <owner>/<name>"
+		if len(notFoundError.Candidates) != 0 {
+			errDetail = fmt.Sprintf("%s. Similar models visible to the user: %s", errDetail, strings.Join(notFoundError.Candidates, ", "))
+		}
+
+		return diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Model %q not found", notFoundError.Model),
+			Detail:   errDetail,
+		}}
+	}
+	var ambiguousError *juju.AmbiguousModelError
+	if errors.As(err, &ambiguousError) {
+		return diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Model name %q is ambiguous", ambiguousError.Model),
+			Detail:   fmt.Sprintf("Several users own a model with this name. Reference the model as one of: %s", strings.Join(ambiguousError.Candidates, ", ")),
+		}}
+	}
+	return diag.FromErr(err)
+}
+
+// validateDuration validates that a string attribute is a duration
+// such as `5m` or `1h30m`.
+func validateDuration(value interface{}, key string) ([]string, []error) {
+	if _, err := time.ParseDuration(value.(string)); err != nil {
+		return nil, []error{fmt.Errorf("%q must be a duration such as 5m or 1h30m: %s", key, err)}
+	}
+	return nil, nil
+}
@@ -140,6 +140,11 @@ func testAccPreCheck(t *testing.T) {
 		}
 	}
 
+	// keep the client credentials of the tests away from the local Juju client
+	if v := os.Getenv(JujuClientCredentialStoreEnvKey); v == "" {
+		t.Setenv(JujuClientCredentialStoreEnvKey, t.TempDir())
+	}
+
 	err := Provider.Configure(context.Background(), terraform.NewResourceConfigRaw(nil))
 	if err != nil {
 		t.Fatal(err)
@@ -0,0 +1,210 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/juju/terraform-provider-juju/internal/juju"
+)
+
+func resourceAccessCloud() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "A resource that represent a Juju Access Cloud.",
+
+		CreateContext: resourceAccessCloudCreate,
+		ReadContext:   resourceAccessCloudRead,
+		UpdateContext: resourceAccessCloudUpdate,
+		DeleteContext: resourceAccessCloudDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceAccessCloudImporter,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cloud": {
+				Description: "The name of the cloud for access management",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"users": {
+				Description: "List of users to grant access to",
+				Type:        schema.TypeList,
+				Required:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"access": {
+				Description:  "Type of access to the cloud. Removing the users revokes this access: add-model access is removed while admin users are left with add-model access. An admin satisfies an add-model resource and is left untouched by it.",
+				ValidateFunc: validation.StringInSlice(juju.CloudAccessLevels, false),
+				Type:         schema.TypeString,
+				ForceNew:     true,
+				Required:     true,
+			},
+		},
+	}
+}
+
+func resourceAccessCloudCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*juju.Client)
+
+	var diags diag.Diagnostics
+
+	cloud := d.Get("cloud").(string)
+	access := d.Get("access").(string)
+	usersInterface := d.Get("users").([]interface{})
+	users := make([]string, len(usersInterface))
+	for i, v := range usersInterface {
+		users[i] = v.(string)
+	}
+
+	for _, user := range users {
+		err := client.Clouds.GrantCloud(juju.GrantCloudInput{
+			Cloud:  cloud,
+			User:   user,
+			Access: access,
+		})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", cloud, access))
+
+	return diags
+}
+
+func resourceAccessCloudRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*juju.Client)
+
+	var diags diag.Diagnostics
+
+	id := strings.Split(d.Id(), ":")
+	usersInterface := d.Get("users").([]interface{})
+	stateUsers := make([]string, len(usersInterface))
+	for i, v := range usersInterface {
+		stateUsers[i] = v.(string)
+	}
+
+	response, err := client.Clouds.ReadAccessCloud(juju.ReadAccessCloudInput{
+		Cloud: id[0],
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("cloud", id[0]); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("access", id[1]); err != nil {
+		return diag.FromErr(err)
+	}
+
+	var users []string
+
+	// a greater access, e.g. managed by another resource, includes the
+	// access of the resource
+	for _, user := range stateUsers {
+		if level := juju.CloudAccessLevel(response.Users[user]); level >= 0 && level >= juju.CloudAccessLevel(id[1]) {
+			users = append(users, user)
+		}
+	}
+
+	if err = d.Set("users", users); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+// Updating the access cloud revokes the access of the users removed
+// from the list and grants it to the users added to it. Changing the
+// access replaces the resource.
+func resourceAccessCloudUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*juju.Client)
+
+	var diags diag.Diagnostics
+
+	if !d.HasChange("users") {
+		return diags
+	}
+
+	oldUsers, newUsers := d.GetChange("users")
+	oldUsersInterface := oldUsers.([]interface{})
+	oldUsersList := make([]string, len(oldUsersInterface))
+	for i, v := range oldUsersInterface {
+		oldUsersList[i] = v.(string)
+	}
+	newUsersInterface := newUsers.([]interface{})
+	newUsersList := make([]string, len(newUsersInterface))
+	for i, v := range newUsersInterface {
+		newUsersList[i] = v.(string)
+	}
+
+	err := client.Clouds.UpdateAccessCloud(juju.UpdateAccessCloudInput{
+		Cloud:  d.Get("cloud").(string),
+		Grant:  getAddedUsers(oldUsersList, newUsersList),
+		Revoke: getMissingUsers(oldUsersList, newUsersList),
+		Access: d.Get("access").(string),
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+// resourceAccessCloudDelete revokes the access of the users to the cloud.
+func resourceAccessCloudDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*juju.Client)
+
+	var diags diag.Diagnostics
+
+	usersInterface := d.Get("users").([]interface{})
+	users := make([]string, len(usersInterface))
+	for i, v := range usersInterface {
+		users[i] = v.(string)
+	}
+
+	err := client.Clouds.DestroyAccessCloud(juju.DestroyAccessCloudInput{
+		Cloud:  d.Get("cloud").(string),
+		Revoke: users,
+		Access: d.Get("access").(string),
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+func resourceAccessCloudImporter(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	id := strings.Split(d.Id(), ":")
+	if len(id) != 3 {
+		return nil, fmt.Errorf("invalid access cloud ID %q, expected <cloud>:<access>:<users>", d.Id())
+	}
+	cloud := id[0]
+	access := id[1]
+	users := strings.Split(id[2], ",")
+
+	if err := d.Set("cloud", cloud); err != nil {
+		return nil, err
+	}
+	if err := d.Set("access", access); err != nil {
+		return nil, err
+	}
+	if err := d.Set("users", users); err != nil {
+		return nil, err
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", cloud, access))
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,110 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAcc_ResourceAccessCloud_Basic(t *testing.T) {
+	userName := acctest.RandomWithPrefix("tfuser")
+	userName2 := acctest.RandomWithPrefix("tfuser")
+	userPassword := acctest.RandomWithPrefix("tf-test-user")
+	cloudName := "localhost"
+	access := "add-model"
+
+	resourceName := "juju_access_cloud.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceAccessCloud(userName, userName2, userPassword, cloudName, access, "[juju_user.one.name]"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "cloud", cloudName),
+					resource.TestCheckResourceAttr(resourceName, "access", access),
+					resource.TestCheckResourceAttr(resourceName, "users.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "users.*", userName),
+				),
+			},
+			{
+				Config: testAccResourceAccessCloud(userName, userName2, userPassword, cloudName, access, "[juju_user.two.name]"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "users.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "users.*", userName2),
+				),
+			},
+			{
+				ImportStateVerify: true,
+				ImportState:       true,
+				ImportStateId:     fmt.Sprintf("%s:%s:%s", cloudName, access, userName2),
+				ResourceName:      resourceName,
+			},
+		},
+	})
+}
+
+func testAccResourceAccessCloud(userName, userName2, userPassword, cloudName, access, users string) string {
+	return fmt.Sprintf(`
+resource "juju_user" "one" {
+  name = %q
+  password = %q
+}
+
+resource "juju_user" "two" {
+  name = %q
+  password = %q
+}
+
+resource "juju_access_cloud" "test" {
+  cloud = %q
+  access = %q
+  users = %s
+}`, userName, userPassword, userName2, userPassword, cloudName, access, users)
+}
+
+func TestAcc_ResourceAccessCloud_Admin(t *testing.T) {
+	userName := acctest.RandomWithPrefix("tfuser")
+	userPassword := acctest.RandomWithPrefix("tf-test-user")
+
+	// the admin access includes the add-model access
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceAccessCloudAdmin(userName, userPassword),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemAttr("juju_access_cloud.add_model", "users.*", userName),
+					resource.TestCheckTypeSetElemAttr("juju_access_cloud.admin", "users.*", userName),
+				),
+			},
+			{
+				Config:   testAccResourceAccessCloudAdmin(userName, userPassword),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func testAccResourceAccessCloudAdmin(userName, userPassword string) string {
+	return fmt.Sprintf(`
+resource "juju_user" "this" {
+  name = %q
+  password = %q
+}
+
+resource "juju_access_cloud" "add_model" {
+  cloud = "localhost"
+  access = "add-model"
+  users = [juju_user.this.name]
+}
+
+resource "juju_access_cloud" "admin" {
+  cloud = "localhost"
+  access = "admin"
+  users = [juju_user.this.name]
+}`, userName, userPassword)
+}
@@ -0,0 +1,198 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/juju/terraform-provider-juju/internal/juju"
+)
+
+// accessControllerPrefix is the first part of the ID of the access
+// controller resources, the controller of the provider being the only one.
+const accessControllerPrefix = "controller"
+
+func resourceAccessController() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "A resource that represent a Juju Access Controller.",
+
+		CreateContext: resourceAccessControllerCreate,
+		ReadContext:   resourceAccessControllerRead,
+		UpdateContext: resourceAccessControllerUpdate,
+		DeleteContext: resourceAccessControllerDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceAccessControllerImporter,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"users": {
+				Description: "List of users to grant access to",
+				Type:        schema.TypeList,
+				Required:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"access": {
+				Description:  "Type of access to the controller. Removing the users revokes this access: login access is removed while superuser users are left with login access. A superuser satisfies a login resource and is left untouched by it.",
+				ValidateFunc: validation.StringInSlice(juju.ControllerAccessLevels, false),
+				Type:         schema.TypeString,
+				ForceNew:     true,
+				Required:     true,
+			},
+		},
+	}
+}
+
+func resourceAccessControllerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*juju.Client)
+
+	var diags diag.Diagnostics
+
+	access := d.Get("access").(string)
+	usersInterface := d.Get("users").([]interface{})
+	users := make([]string, len(usersInterface))
+	for i, v := range usersInterface {
+		users[i] = v.(string)
+	}
+
+	for _, user := range users {
+		err := client.Controllers.GrantController(juju.GrantControllerInput{
+			User:   user,
+			Access: access,
+		})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", accessControllerPrefix, access))
+
+	return diags
+}
+
+func resourceAccessControllerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*juju.Client)
+
+	var diags diag.Diagnostics
+
+	id := strings.Split(d.Id(), ":")
+	usersInterface := d.Get("users").([]interface{})
+	stateUsers := make([]string, len(usersInterface))
+	for i, v := range usersInterface {
+		stateUsers[i] = v.(string)
+	}
+
+	response, err := client.Controllers.ReadAccessController(juju.ReadAccessControllerInput{
+		Users: stateUsers,
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("access", id[1]); err != nil {
+		return diag.FromErr(err)
+	}
+
+	var users []string
+
+	// a greater access, e.g. managed by another resource, includes the
+	// access of the resource
+	for _, user := range stateUsers {
+		if level := juju.ControllerAccessLevel(response.Users[user]); level >= 0 && level >= juju.ControllerAccessLevel(id[1]) {
+			users = append(users, user)
+		}
+	}
+
+	if err = d.Set("users", users); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+// Updating the access controller revokes the access of the users
+// removed from the list and grants it to the users added to it.
+// Changing the access replaces the resource.
+func resourceAccessControllerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*juju.Client)
+
+	var diags diag.Diagnostics
+
+	if !d.HasChange("users") {
+		return diags
+	}
+
+	oldUsers, newUsers := d.GetChange("users")
+	oldUsersInterface := oldUsers.([]interface{})
+	oldUsersList := make([]string, len(oldUsersInterface))
+	for i, v := range oldUsersInterface {
+		oldUsersList[i] = v.(string)
+	}
+	newUsersInterface := newUsers.([]interface{})
+	newUsersList := make([]string, len(newUsersInterface))
+	for i, v := range newUsersInterface {
+		newUsersList[i] = v.(string)
+	}
+
+	err := client.Controllers.UpdateAccessController(juju.UpdateAccessControllerInput{
+		Grant:  getAddedUsers(oldUsersList, newUsersList),
+		Revoke: getMissingUsers(oldUsersList, newUsersList),
+		Access: d.Get("access").(string),
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+// resourceAccessControllerDelete revokes the access of the users to the
+// controller.
+func resourceAccessControllerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*juju.Client)
+
+	var diags diag.Diagnostics
+
+	usersInterface := d.Get("users").([]interface{})
+	users := make([]string, len(usersInterface))
+	for i, v := range usersInterface {
+		users[i] = v.(string)
+	}
+
+	err := client.Controllers.DestroyAccessController(juju.DestroyAccessControllerInput{
+		Revoke: users,
+		Access: d.Get("access").(string),
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+func resourceAccessControllerImporter(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	id := strings.Split(d.Id(), ":")
+	if len(id) != 3 || id[0] != accessControllerPrefix {
+		return nil, fmt.Errorf("invalid access controller ID %q, expected controller:<access>:<users>", d.Id())
+	}
+	access := id[1]
+	users := strings.Split(id[2], ",")
+
+	if err := d.Set("access", access); err != nil {
+		return nil, err
+	}
+	if err := d.Set("users", users); err != nil {
+		return nil, err
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", accessControllerPrefix, access))
+
+	return []*schema.ResourceData{d}, nil
+}
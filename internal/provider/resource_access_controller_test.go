@@ -0,0 +1,135 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/juju/terraform-provider-juju/internal/juju"
+)
+
+func TestAcc_ResourceAccessController_Basic(t *testing.T) {
+	userName := acctest.RandomWithPrefix("tfuser")
+	userPassword := acctest.RandomWithPrefix("tf-test-user")
+	access := "superuser"
+	accessFail := "add-model"
+
+	resourceName := "juju_access_controller.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResourceAccessController(userName, userPassword, accessFail),
+				ExpectError: regexp.MustCompile("expected access to be one of.*"),
+			},
+			{
+				Config: testAccResourceAccessController(userName, userPassword, access),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "controller:superuser"),
+					resource.TestCheckResourceAttr(resourceName, "access", access),
+					resource.TestCheckTypeSetElemAttr(resourceName, "users.*", userName),
+				),
+			},
+			{
+				ImportStateVerify: true,
+				ImportState:       true,
+				ImportStateId:     fmt.Sprintf("controller:%s:%s", access, userName),
+				ResourceName:      resourceName,
+			},
+		},
+	})
+}
+
+func TestAcc_ResourceAccessController_Login(t *testing.T) {
+	userName := acctest.RandomWithPrefix("tfuser")
+	userPassword := acctest.RandomWithPrefix("tf-test-user")
+
+	// users are created with login access, granting it again succeeds
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceAccessController(userName, userPassword, "login"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemAttr("juju_access_controller.test", "users.*", userName),
+				),
+			},
+			{
+				// the access revoked outside Terraform is granted again
+				PreConfig: func() {
+					client := Provider.Meta().(*juju.Client)
+					err := client.Controllers.UpdateAccessController(juju.UpdateAccessControllerInput{
+						Revoke: []string{userName},
+						Access: "login",
+					})
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccResourceAccessController(userName, userPassword, "login"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemAttr("juju_access_controller.test", "users.*", userName),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_ResourceAccessController_Superuser(t *testing.T) {
+	userName := acctest.RandomWithPrefix("tfuser")
+	userPassword := acctest.RandomWithPrefix("tf-test-user")
+
+	// the superuser access includes the login access
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceAccessControllerSuperuser(userName, userPassword),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemAttr("juju_access_controller.login", "users.*", userName),
+					resource.TestCheckTypeSetElemAttr("juju_access_controller.superuser", "users.*", userName),
+				),
+			},
+			{
+				Config:   testAccResourceAccessControllerSuperuser(userName, userPassword),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func testAccResourceAccessControllerSuperuser(userName, userPassword string) string {
+	return fmt.Sprintf(`
+resource "juju_user" "this" {
+  name = %q
+  password = %q
+}
+
+resource "juju_access_controller" "login" {
+  access = "login"
+  users = [juju_user.this.name]
+}
+
+resource "juju_access_controller" "superuser" {
+  access = "superuser"
+  users = [juju_user.this.name]
+}`, userName, userPassword)
+}
+
+func testAccResourceAccessController(userName, userPassword, access string) string {
+	return fmt.Sprintf(`
+resource "juju_user" "this" {
+  name = %q
+  password = %q
+}
+
+resource "juju_access_controller" "test" {
+  access = %q
+  users = [juju_user.this.name]
+}`, userName, userPassword, access)
+}
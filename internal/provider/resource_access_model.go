@@ -3,14 +3,25 @@ package provider
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/juju/names/v4"
 	"github.com/juju/terraform-provider-juju/internal/juju"
 )
 
+const (
+	// accessModeAdditive only grants the access to the users of the
+	// resource and revokes it from the users removed from it.
+	accessModeAdditive = "additive"
+	// accessModeAuthoritative makes the users of the resource the only
+	// ones with the access, fixing their access when it differs.
+	accessModeAuthoritative = "authoritative"
+)
+
 func resourceAccessModel() *schema.Resource {
 	return &schema.Resource{
 		// This description is used by the documentation generator and the language server.
@@ -26,7 +37,7 @@ func resourceAccessModel() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"model": {
-				Description: "The name of the model for access management",
+				Description: "The name of the model for access management, qualified with its owner as `owner/name` for models owned by other users",
 				Type:        schema.TypeString,
 				Required:    true,
 				ForceNew:    true,
@@ -46,6 +57,15 @@ func resourceAccessModel() *schema.Resource {
 				ForceNew:     true,
 				Required:     true,
 			},
+			"mode": {
+				Description: "How the access of the users is managed. With `additive`, the access is granted to the users and the users removed " +
+					"from the list are removed from the model. With `authoritative`, the users are given exactly this access, other users with it " +
+					"are removed from the model and users with a greater access are downgraded. The model owner is never changed.",
+				ValidateFunc: validation.StringInSlice([]string{accessModeAdditive, accessModeAuthoritative}, false),
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      accessModeAdditive,
+			},
 		},
 	}
 }
@@ -53,138 +73,147 @@ func resourceAccessModel() *schema.Resource {
 func resourceAccessModelCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*juju.Client)
 
-	var diags diag.Diagnostics
-
 	model := d.Get("model").(string)
 	access := d.Get("access").(string)
-	usersInterface := d.Get("users").([]interface{})
-	users := make([]string, len(usersInterface))
-	for i, v := range usersInterface {
-		users[i] = v.(string)
-	}
+	users := accessModelUsers(d.Get("users"))
 
 	uuid, err := client.Models.ResolveModelUUID(model)
 	if err != nil {
-		return diag.FromErr(err)
+		return checkModelErr(err)
 	}
 
-	modelUUIDs := []string{uuid}
-
-	for _, user := range users {
-		err := client.Models.GrantModel(juju.GrantModelInput{
-			User:       user,
-			Access:     access,
-			ModelUUIDs: modelUUIDs,
+	if d.Get("mode").(string) == accessModeAuthoritative {
+		err = client.Models.SetAccessModel(juju.SetAccessModelInput{
+			ModelUUID: uuid,
+			Users:     users,
+			Access:    access,
 		})
 		if err != nil {
 			return diag.FromErr(err)
 		}
+	} else {
+		for _, user := range users {
+			err := client.Models.GrantModel(juju.GrantModelInput{
+				User:       user,
+				Access:     access,
+				ModelUUIDs: []string{uuid},
+			})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		}
 	}
 
-	d.SetId(fmt.Sprintf("%s:%s", model, access))
+	d.SetId(newAccessModelID(uuid, access))
 
-	return diags
+	return resourceAccessModelRead(ctx, d, meta)
 }
 
 func resourceAccessModelRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*juju.Client)
 
-	var diags diag.Diagnostics
-
-	id := strings.Split(d.Id(), ":")
-	usersInterface := d.Get("users").([]interface{})
-	stateUsers := make([]string, len(usersInterface))
-	for i, v := range usersInterface {
-		stateUsers[i] = v.(string)
+	modelID, access, err := parseAccessModelID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
-	uuid, err := client.Models.ResolveModelUUID(id[0])
+	uuid, err := accessModelUUID(client, modelID)
 	if err != nil {
-		return diag.FromErr(err)
+		return checkModelErr(err)
 	}
-	response, err := client.Users.ModelUserInfo(uuid)
+	d.SetId(newAccessModelID(uuid, access))
+
+	response, err := client.Models.ReadAccessModel(juju.ReadAccessModelInput{
+		ModelUUID: uuid,
+	})
 	if err != nil {
-		return diag.FromErr(err)
+		return checkModelErr(err)
 	}
 
-	if err := d.Set("model", id[0]); err != nil {
+	if err := d.Set("access", access); err != nil {
 		return diag.FromErr(err)
 	}
-	if err := d.Set("access", id[1]); err != nil {
-		return diag.FromErr(err)
+	if d.Get("mode").(string) == "" {
+		if err := d.Set("mode", accessModeAdditive); err != nil {
+			return diag.FromErr(err)
+		}
 	}
 
+	// The users are kept in the order of the state, authoritative
+	// resources appending the users given the access outside of it.
+	stateUsers := accessModelUsers(d.Get("users"))
 	var users []string
-
 	for _, user := range stateUsers {
-		for _, modelUser := range response.ModelUserInfo {
-			if user == modelUser.UserName && string(modelUser.Access) == id[1] {
-				users = append(users, modelUser.UserName)
+		if response.Users[user] == access {
+			users = append(users, user)
+		}
+	}
+	if d.Get("mode").(string) == accessModeAuthoritative {
+		var others []string
+		for user, userAccess := range response.Users {
+			if userAccess == access && user != response.Owner && !containsString(stateUsers, user) {
+				others = append(others, user)
 			}
 		}
+		sort.Strings(others)
+		users = append(users, others...)
 	}
 
 	if err = d.Set("users", users); err != nil {
 		return diag.FromErr(err)
 	}
 
-	return diags
+	return nil
 }
 
-// Updating the access model supports three cases
-// access and users both changed:
-// for missing users - revoke access
-// for changed users - apply new access
-// users changed:
-// for missing users - revoke access
-// for new users - apply access
-// access changed - apply new access
+// Updating the access model in additive mode revokes the access of the
+// users removed from the list and grants it to the users added to it. In
+// authoritative mode the access of all users is set again, fixing the
+// access changed outside of Terraform. Changing the access replaces the
+// resource.
 func resourceAccessModelUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*juju.Client)
 
-	var diags diag.Diagnostics
-	anyChange := false
-
-	// items that could be changed
-	var newAccess string
-	var newUsersList []string
-	var missingUserList []string
-	var addedUserList []string
-
-	var err error
+	modelID, access, err := parseAccessModelID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	uuid, err := accessModelUUID(client, modelID)
+	if err != nil {
+		return checkModelErr(err)
+	}
 
-	if d.HasChange("users") {
-		anyChange = true
-		oldUsers, newUsers := d.GetChange("users")
-		oldUsersInterface := oldUsers.([]interface{})
-		oldUsersList := make([]string, len(oldUsersInterface))
-		for i, v := range oldUsersInterface {
-			oldUsersList[i] = v.(string)
-		}
-		newUsersInterface := newUsers.([]interface{})
-		newUsersList = make([]string, len(newUsersInterface))
-		for i, v := range newUsersInterface {
-			newUsersList[i] = v.(string)
+	if d.Get("mode").(string) == accessModeAuthoritative {
+		err = client.Models.SetAccessModel(juju.SetAccessModelInput{
+			ModelUUID: uuid,
+			Users:     accessModelUsers(d.Get("users")),
+			Access:    access,
+		})
+		if err != nil {
+			return diag.FromErr(err)
 		}
-		missingUserList = getMissingUsers(oldUsersList, newUsersList)
-		addedUserList = getAddedUsers(oldUsersList, newUsersList)
+		return resourceAccessModelRead(ctx, d, meta)
 	}
 
-	if !anyChange {
-		return diags
+	if !d.HasChange("users") {
+		return nil
 	}
 
+	oldUsers, newUsers := d.GetChange("users")
+	oldUsersList := accessModelUsers(oldUsers)
+	newUsersList := accessModelUsers(newUsers)
+
 	err = client.Models.UpdateAccessModel(juju.UpdateAccessModelInput{
-		Model:  d.Id(),
-		Grant:  addedUserList,
-		Revoke: missingUserList,
-		Access: newAccess,
+		ModelUUID: uuid,
+		Grant:     getAddedUsers(oldUsersList, newUsersList),
+		Revoke:    getMissingUsers(oldUsersList, newUsersList),
+		Access:    access,
 	})
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	return diags
+	return resourceAccessModelRead(ctx, d, meta)
 }
 
 func getMissingUsers(oldUsers, newUsers []string) []string {
@@ -227,19 +256,18 @@ func getAddedUsers(oldUsers, newUsers []string) []string {
 func resourceAccessModelDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*juju.Client)
 
-	var diags diag.Diagnostics
-
-	usersInterface := d.Get("users").([]interface{})
-	users := make([]string, len(usersInterface))
-	for i, v := range usersInterface {
-		users[i] = v.(string)
+	modelID, _, err := parseAccessModelID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	uuid, err := accessModelUUID(client, modelID)
+	if err != nil {
+		return checkModelErr(err)
 	}
-	access := d.Get("access").(string)
 
-	err := client.Models.DestroyAccessModel(juju.DestroyAccessModelInput{
-		Model:  d.Id(),
-		Revoke: users,
-		Access: access,
+	err = client.Models.DestroyAccessModel(juju.DestroyAccessModelInput{
+		ModelUUID: uuid,
+		Revoke:    accessModelUsers(d.Get("users")),
 	})
 	if err != nil {
 		return diag.FromErr(err)
@@ -247,14 +275,32 @@ func resourceAccessModelDelete(ctx context.Context, d *schema.ResourceData, meta
 
 	d.SetId("")
 
-	return diags
+	return nil
 }
 
+// resourceAccessModelImporter imports IDs of the form
+// <model>:<access>:<users> where users is a comma separated list,
+// setting the mode given as an optional fourth part.
 func resourceAccessModelImporter(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	id := strings.Split(d.Id(), ":")
-	model := id[0]
-	access := id[1]
-	users := strings.Split(id[2], ",")
+	client := meta.(*juju.Client)
+
+	id, mode := d.Id(), accessModeAdditive
+	if rest, last, ok := cutLast(id, ":"); ok && (last == accessModeAdditive || last == accessModeAuthoritative) {
+		id, mode = rest, last
+	}
+	rest, usersPart, ok := cutLast(id, ":")
+	if !ok {
+		return nil, fmt.Errorf("invalid access model import ID %q, expected <model>:<access>:<users>[:<mode>]", d.Id())
+	}
+	model, access, err := parseAccessModelID(rest)
+	if err != nil {
+		return nil, err
+	}
+
+	uuid, err := client.Models.ResolveModelUUID(model)
+	if err != nil {
+		return nil, err
+	}
 
 	if err := d.Set("model", model); err != nil {
 		return nil, err
@@ -262,11 +308,66 @@ func resourceAccessModelImporter(ctx context.Context, d *schema.ResourceData, me
 	if err := d.Set("access", access); err != nil {
 		return nil, err
 	}
-	if err := d.Set("users", users); err != nil {
+	if err := d.Set("users", strings.Split(usersPart, ",")); err != nil {
+		return nil, err
+	}
+	if err := d.Set("mode", mode); err != nil {
 		return nil, err
 	}
 
-	d.SetId(fmt.Sprintf("%s:%s", model, access))
+	d.SetId(newAccessModelID(uuid, access))
 
 	return []*schema.ResourceData{d}, nil
 }
+
+// newAccessModelID returns the ID of an access model resource,
+// <model uuid>:<access>.
+func newAccessModelID(uuid, access string) string {
+	return fmt.Sprintf("%s:%s", uuid, access)
+}
+
+// parseAccessModelID returns the model and the access of an access model
+// ID. The model is a UUID, or a model name for IDs created by earlier
+// versions of the provider.
+func parseAccessModelID(id string) (model, access string, err error) {
+	model, access, ok := cutLast(id, ":")
+	if !ok || model == "" || juju.ModelAccessLevel(access) < 0 {
+		return "", "", fmt.Errorf("invalid access model ID %q, expected <model>:<access>", id)
+	}
+	return model, access, nil
+}
+
+// accessModelUUID returns the UUID of the model of an access model ID,
+// resolving the model name of IDs created by earlier versions.
+func accessModelUUID(client *juju.Client, model string) (string, error) {
+	if names.IsValidModel(model) {
+		return model, nil
+	}
+	return client.Models.ResolveModelUUID(model)
+}
+
+// cutLast slices s around the last instance of sep.
+func cutLast(s, sep string) (before, after string, found bool) {
+	if i := strings.LastIndex(s, sep); i >= 0 {
+		return s[:i], s[i+len(sep):], true
+	}
+	return s, "", false
+}
+
+func accessModelUsers(users interface{}) []string {
+	usersInterface := users.([]interface{})
+	list := make([]string, len(usersInterface))
+	for i, v := range usersInterface {
+		list[i] = v.(string)
+	}
+	return list
+}
+
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
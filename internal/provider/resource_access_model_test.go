@@ -7,6 +7,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/juju/terraform-provider-juju/internal/juju"
 )
 
 func TestAcc_ResourceAccessModel_Basic(t *testing.T) {
@@ -30,6 +31,8 @@ func TestAcc_ResourceAccessModel_Basic(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(resourceName, "access", access),
 					resource.TestCheckResourceAttr(resourceName, "model", modelName),
+					resource.TestCheckResourceAttr(resourceName, "mode", "additive"),
+					resource.TestMatchResourceAttr(resourceName, "id", regexp.MustCompile("^[0-9a-f-]{36}:write$")),
 					resource.TestCheckTypeSetElemAttr(resourceName, "users.*", userName),
 				),
 			},
@@ -60,3 +63,104 @@ resource "juju_access_model" "test" {
   users = [juju_user.this.name]
 }`, userName, userPassword, modelName, access)
 }
+
+func TestAcc_ResourceAccessModel_Authoritative(t *testing.T) {
+	userName := acctest.RandomWithPrefix("tfuser")
+	userPassword := acctest.RandomWithPrefix("tf-test-user")
+	modelName := acctest.RandomWithPrefix("tf-test-access")
+
+	resourceName := "juju_access_model.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceAccessModelAuthoritative(userName, userPassword, modelName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "mode", "authoritative"),
+					resource.TestCheckResourceAttr(resourceName, "users.#", "1"),
+				),
+			},
+			{
+				// the user given admin outside of Terraform is downgraded
+				PreConfig: func() {
+					client := Provider.Meta().(*juju.Client)
+					uuid, err := client.Models.ResolveModelUUID(modelName)
+					if err != nil {
+						t.Fatal(err)
+					}
+					err = client.Models.GrantModel(juju.GrantModelInput{
+						User:       userName,
+						Access:     "admin",
+						ModelUUIDs: []string{uuid},
+					})
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccResourceAccessModelAuthoritative(userName, userPassword, modelName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "users.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "users.*", userName),
+				),
+			},
+			{
+				ImportStateVerify: true,
+				ImportState:       true,
+				ImportStateId:     fmt.Sprintf("%s:write:%s:authoritative", modelName, userName),
+				ResourceName:      resourceName,
+			},
+		},
+	})
+}
+
+func TestParseAccessModelID(t *testing.T) {
+	tests := []struct {
+		id     string
+		model  string
+		access string
+		err    bool
+	}{
+		{id: "a2ae5a8e-7a0b-4a9f-8ed7-4d1bb5a31a2f:write", model: "a2ae5a8e-7a0b-4a9f-8ed7-4d1bb5a31a2f", access: "write"},
+		{id: "development:read", model: "development", access: "read"},
+		{id: "alice/development:admin", model: "alice/development", access: "admin"},
+		{id: "development", err: true},
+		{id: ":read", err: true},
+		{id: "development:consume", err: true},
+	}
+	for _, test := range tests {
+		model, access, err := parseAccessModelID(test.id)
+		if test.err {
+			if err == nil {
+				t.Errorf("%q: expected an error", test.id)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%q: unexpected error: %v", test.id, err)
+			continue
+		}
+		if model != test.model || access != test.access {
+			t.Errorf("%q: got %q, %q", test.id, model, access)
+		}
+	}
+}
+
+func testAccResourceAccessModelAuthoritative(userName, userPassword, modelName string) string {
+	return fmt.Sprintf(`
+resource "juju_user" "this" {
+  name = %q
+  password = %q
+}
+
+resource "juju_model" "this" {
+  name = %q
+}
+
+resource "juju_access_model" "test" {
+  access = "write"
+  mode = "authoritative"
+  model = juju_model.this.name
+  users = [juju_user.this.name]
+}`, userName, userPassword, modelName)
+}
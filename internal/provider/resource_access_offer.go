@@ -0,0 +1,227 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/juju/errors"
+	"github.com/juju/terraform-provider-juju/internal/juju"
+)
+
+func resourceAccessOffer() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "A resource that represent a Juju Access Offer.",
+
+		CreateContext: resourceAccessOfferCreate,
+		ReadContext:   resourceAccessOfferRead,
+		UpdateContext: resourceAccessOfferUpdate,
+		DeleteContext: resourceAccessOfferDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceAccessOfferImporter,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"offer_url": {
+				Description: "The URL of the offer for access management",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"users": {
+				Description: "List of users to grant access to",
+				Type:        schema.TypeList,
+				Required:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"access": {
+				Description:  "Type of access to the offer",
+				ValidateFunc: validation.StringInSlice(juju.OfferAccessLevels, false),
+				Type:         schema.TypeString,
+				ForceNew:     true,
+				Required:     true,
+			},
+		},
+	}
+}
+
+func resourceAccessOfferCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*juju.Client)
+
+	offerURL := d.Get("offer_url").(string)
+	access := d.Get("access").(string)
+	usersInterface := d.Get("users").([]interface{})
+	users := make([]string, len(usersInterface))
+	for i, v := range usersInterface {
+		users[i] = v.(string)
+	}
+
+	for _, user := range users {
+		err := client.Offers.GrantOffer(&juju.GrantOfferInput{
+			User:     user,
+			Access:   access,
+			OfferURL: offerURL,
+		})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", offerURL, access))
+
+	return resourceAccessOfferRead(ctx, d, meta)
+}
+
+func resourceAccessOfferRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*juju.Client)
+
+	var diags diag.Diagnostics
+
+	offerURL, access, _, err := parseAccessOfferID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	usersInterface := d.Get("users").([]interface{})
+	stateUsers := make([]string, len(usersInterface))
+	for i, v := range usersInterface {
+		stateUsers[i] = v.(string)
+	}
+
+	response, err := client.Offers.ReadOffer(&juju.ReadOfferInput{
+		OfferURL: offerURL,
+	})
+	if errors.Is(err, errors.NotFound) {
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("offer_url", offerURL); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("access", access); err != nil {
+		return diag.FromErr(err)
+	}
+
+	var users []string
+	for _, user := range stateUsers {
+		if response.Users[user] == access {
+			users = append(users, user)
+		}
+	}
+
+	if err = d.Set("users", users); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+// Updating the access offer revokes the access of the users removed
+// from the list and grants it to the users added to it. Changing the
+// access replaces the resource.
+func resourceAccessOfferUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*juju.Client)
+
+	if !d.HasChange("users") {
+		return resourceAccessOfferRead(ctx, d, meta)
+	}
+
+	oldUsers, newUsers := d.GetChange("users")
+	oldUsersInterface := oldUsers.([]interface{})
+	oldUsersList := make([]string, len(oldUsersInterface))
+	for i, v := range oldUsersInterface {
+		oldUsersList[i] = v.(string)
+	}
+	newUsersInterface := newUsers.([]interface{})
+	newUsersList := make([]string, len(newUsersInterface))
+	for i, v := range newUsersInterface {
+		newUsersList[i] = v.(string)
+	}
+
+	err := client.Offers.UpdateAccessOffer(&juju.UpdateAccessOfferInput{
+		OfferURL: d.Get("offer_url").(string),
+		Grant:    getAddedUsers(oldUsersList, newUsersList),
+		Revoke:   getMissingUsers(oldUsersList, newUsersList),
+		Access:   d.Get("access").(string),
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceAccessOfferRead(ctx, d, meta)
+}
+
+// resourceAccessOfferDelete revokes the access of the users to the offer.
+func resourceAccessOfferDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*juju.Client)
+
+	var diags diag.Diagnostics
+
+	usersInterface := d.Get("users").([]interface{})
+	users := make([]string, len(usersInterface))
+	for i, v := range usersInterface {
+		users[i] = v.(string)
+	}
+
+	err := client.Offers.DestroyAccessOffer(&juju.DestroyAccessOfferInput{
+		OfferURL: d.Get("offer_url").(string),
+		Revoke:   users,
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+func resourceAccessOfferImporter(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	offerURL, access, users, err := parseAccessOfferID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := d.Set("offer_url", offerURL); err != nil {
+		return nil, err
+	}
+	if err := d.Set("access", access); err != nil {
+		return nil, err
+	}
+	if err := d.Set("users", users); err != nil {
+		return nil, err
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", offerURL, access))
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// parseAccessOfferID parses IDs of the form <offer url>:<access>, with
+// an optional comma separated list of users when importing. The offer
+// URL may itself contain a colon when prefixed with a controller name.
+func parseAccessOfferID(id string) (offerURL, access string, users []string, err error) {
+	parts := strings.Split(id, ":")
+	if len(parts) >= 3 && isOfferAccessLevel(parts[len(parts)-2]) {
+		users = strings.Split(parts[len(parts)-1], ",")
+		parts = parts[:len(parts)-1]
+	}
+	if len(parts) < 2 || !isOfferAccessLevel(parts[len(parts)-1]) {
+		return "", "", nil, fmt.Errorf("invalid access offer ID %q, expected <offer url>:<access>[:<users>]", id)
+	}
+
+	return strings.Join(parts[:len(parts)-1], ":"), parts[len(parts)-1], users, nil
+}
+
+func isOfferAccessLevel(access string) bool {
+	return juju.OfferAccessLevel(access) >= 0
+}
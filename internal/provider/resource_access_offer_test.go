@@ -0,0 +1,120 @@
+package provider
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAcc_ResourceAccessOffer_Basic(t *testing.T) {
+	userName := acctest.RandomWithPrefix("tfuser")
+	userPassword := acctest.RandomWithPrefix("tf-test-user")
+	otherUserName := acctest.RandomWithPrefix("tfuser")
+	modelName := acctest.RandomWithPrefix("tf-test-access-offer")
+	offerURL := fmt.Sprintf("admin/%s.this", modelName)
+
+	resourceName := "juju_access_offer.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceAccessOffer(userName, userPassword, otherUserName, modelName, "[juju_user.this.name]"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "access", "consume"),
+					resource.TestCheckResourceAttr(resourceName, "offer_url", offerURL),
+					resource.TestCheckResourceAttr(resourceName, "users.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "users.0", userName),
+				),
+			},
+			{
+				Config: testAccResourceAccessOffer(userName, userPassword, otherUserName, modelName, "[juju_user.this.name, juju_user.other.name]"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "users.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "users.1", otherUserName),
+				),
+			},
+			{
+				ImportStateVerify: true,
+				ImportState:       true,
+				ImportStateId:     fmt.Sprintf("%s:consume:%s,%s", offerURL, userName, otherUserName),
+				ResourceName:      resourceName,
+			},
+		},
+	})
+}
+
+func testAccResourceAccessOffer(userName, userPassword, otherUserName, modelName, users string) string {
+	return fmt.Sprintf(`
+resource "juju_user" "this" {
+  name = %q
+  password = %q
+}
+
+resource "juju_user" "other" {
+  name = %q
+  password = %q
+}
+
+resource "juju_model" "this" {
+  name = %q
+}
+
+resource "juju_application" "this" {
+  model = juju_model.this.name
+  name  = "this"
+
+  charm {
+    name = "postgresql"
+    series = "focal"
+  }
+}
+
+resource "juju_offer" "this" {
+  model            = juju_model.this.name
+  application_name = juju_application.this.name
+  endpoints        = ["db"]
+}
+
+resource "juju_access_offer" "test" {
+  offer_url = juju_offer.this.url
+  access    = "consume"
+  users     = %s
+}`, userName, userPassword, otherUserName, userPassword, modelName, users)
+}
+
+func TestParseAccessOfferID(t *testing.T) {
+	tests := []struct {
+		id       string
+		offerURL string
+		access   string
+		users    []string
+		err      bool
+	}{
+		{id: "admin/default.db:consume", offerURL: "admin/default.db", access: "consume"},
+		{id: "admin/default.db:read:alice,bob", offerURL: "admin/default.db", access: "read", users: []string{"alice", "bob"}},
+		{id: "ctrl:admin/default.db:admin", offerURL: "ctrl:admin/default.db", access: "admin"},
+		{id: "ctrl:admin/default.db:admin:alice", offerURL: "ctrl:admin/default.db", access: "admin", users: []string{"alice"}},
+		{id: "admin/default.db", err: true},
+		{id: "admin/default.db:write", err: true},
+	}
+	for _, test := range tests {
+		offerURL, access, users, err := parseAccessOfferID(test.id)
+		if test.err {
+			if err == nil {
+				t.Errorf("%q: expected an error", test.id)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%q: unexpected error: %v", test.id, err)
+			continue
+		}
+		if offerURL != test.offerURL || access != test.access || !reflect.DeepEqual(users, test.users) {
+			t.Errorf("%q: got %q, %q, %v", test.id, offerURL, access, users)
+		}
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/juju/juju/core/constraints"
+	"github.com/juju/names/v4"
 	"github.com/juju/terraform-provider-juju/internal/juju"
 )
 
@@ -33,7 +34,7 @@ func resourceApplication() *schema.Resource {
 				ForceNew:    true,
 			},
 			"model": {
-				Description: "The name of the model where the application is to be deployed.",
+				Description: "The name of the model where the application is to be deployed, qualified with its owner as `owner/name` for models owned by other users.",
 				Type:        schema.TypeString,
 				Required:    true,
 				ForceNew:    true,
@@ -85,6 +86,7 @@ func resourceApplication() *schema.Resource {
 					return make(map[string]interface{}), nil
 				},
 			},
+			"annotations": annotationsSchema("application"),
 			"constraints": {
 				Description: "Constraints imposed on this application.",
 				Type:        schema.TypeString,
@@ -148,7 +150,7 @@ func resourceApplicationCreate(ctx context.Context, d *schema.ResourceData, meta
 	modelName := d.Get("model").(string)
 	modelUUID, err := client.Models.ResolveModelUUID(modelName)
 	if err != nil {
-		return diag.FromErr(err)
+		return checkModelErr(err)
 	}
 
 	name := d.Get("name").(string)
@@ -223,6 +225,10 @@ func resourceApplicationCreate(ctx context.Context, d *schema.ResourceData, meta
 	id := fmt.Sprintf("%s:%s", modelName, response.AppName)
 	d.SetId(id)
 
+	if err = updateAnnotations(&client.Annotations, d, modelUUID, names.NewApplicationTag(response.AppName)); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return resourceApplicationRead(ctx, d, meta)
 }
 
@@ -237,7 +243,7 @@ func resourceApplicationRead(ctx context.Context, d *schema.ResourceData, meta i
 	modelName, appName := id[0], id[1]
 	modelUUID, err := client.Models.ResolveModelUUID(modelName)
 	if err != nil {
-		return diag.FromErr(err)
+		return checkModelErr(err)
 	}
 
 	response, err := client.Applications.ReadApplication(&juju.ReadApplicationInput{
@@ -342,6 +348,10 @@ func resourceApplicationRead(ctx context.Context, d *schema.ResourceData, meta i
 		return diag.FromErr(err)
 	}
 
+	if err = readAnnotations(&client.Annotations, d, modelUUID, names.NewApplicationTag(appName)); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return nil
 }
 
@@ -352,7 +362,7 @@ func resourceApplicationUpdate(ctx context.Context, d *schema.ResourceData, meta
 	modelName := d.Get("model").(string)
 	modelInfo, err := client.Models.GetModelByName(modelName)
 	if err != nil {
-		return diag.FromErr(err)
+		return checkModelErr(err)
 	}
 	updateApplicationInput := juju.UpdateApplicationInput{
 		ModelUUID: modelInfo.UUID,
@@ -418,6 +428,10 @@ func resourceApplicationUpdate(ctx context.Context, d *schema.ResourceData, meta
 		return diag.FromErr(err)
 	}
 
+	if err = updateAnnotations(&client.Annotations, d, modelInfo.UUID, names.NewApplicationTag(appName)); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return resourceApplicationRead(ctx, d, meta)
 }
 
@@ -484,7 +498,7 @@ func resourceApplicationDelete(ctx context.Context, d *schema.ResourceData, meta
 	modelName := d.Get("model").(string)
 	modelUUID, err := client.Models.ResolveModelUUID(modelName)
 	if err != nil {
-		return diag.FromErr(err)
+		return checkModelErr(err)
 	}
 
 	var diags diag.Diagnostics
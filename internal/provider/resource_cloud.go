@@ -0,0 +1,278 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/juju/errors"
+	jujucloud "github.com/juju/juju/cloud"
+	"github.com/juju/terraform-provider-juju/internal/juju"
+)
+
+// The Cloud resource maps to a cloud of the controller, operated via
+// `juju add-cloud --controller`, `juju update-cloud --controller` and
+// `juju remove-cloud --controller`.
+func resourceCloud() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "A resource that represent a Juju Cloud existing on the controller.",
+
+		CreateContext: resourceCloudCreate,
+		ReadContext:   resourceCloudRead,
+		UpdateContext: resourceCloudUpdate,
+		DeleteContext: resourceCloudDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Description: "The name of the cloud.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"type": {
+				Description: "The type of the cloud, e.g. `openstack`, `maas`, `lxd` or `kubernetes`.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"auth_types": {
+				Description: "The authentication types supported by the cloud, e.g. `userpass` or `certificate`.",
+				Type:        schema.TypeSet,
+				Required:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"endpoint": {
+				Description: "The default endpoint of the cloud.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"identity_endpoint": {
+				Description: "The default identity endpoint of the cloud.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"storage_endpoint": {
+				Description: "The default storage endpoint of the cloud.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"regions": {
+				Description: "The regions of the cloud. The first region is the default one. Regions without endpoints use the endpoints of the cloud.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Description: "The name of the region.",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+						"endpoint": {
+							Description: "The endpoint of the region.",
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+						},
+						"identity_endpoint": {
+							Description: "The identity endpoint of the region.",
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+						},
+						"storage_endpoint": {
+							Description: "The storage endpoint of the region.",
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"ca_certificates": {
+				Description: "The PEM encoded CA certificates used to verify the endpoints of the cloud.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Sensitive:   true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"config": {
+				Description: "The model configuration defaults for models of the cloud.",
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"force": {
+				Description: "Add the cloud even when its type differs from the type of the controller cloud.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+		},
+	}
+}
+
+func resourceCloudCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*juju.Client)
+
+	err := client.Clouds.AddCloud(juju.AddCloudInput{
+		Cloud: cloudFromResourceData(d),
+		Force: d.Get("force").(bool),
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(d.Get("name").(string))
+
+	return resourceCloudRead(ctx, d, meta)
+}
+
+func resourceCloudRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*juju.Client)
+
+	response, err := client.Clouds.ReadCloud(juju.ReadCloudInput{
+		Name: d.Id(),
+	})
+	if errors.Is(err, errors.NotFound) {
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloud := response.Cloud
+	if err = d.Set("name", cloud.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("type", cloud.Type); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("auth_types", cloudAuthTypes(cloud.AuthTypes)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("endpoint", cloud.Endpoint); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("identity_endpoint", cloud.IdentityEndpoint); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("storage_endpoint", cloud.StorageEndpoint); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("regions", flattenCloudRegions(cloud.Regions)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("ca_certificates", cloud.CACertificates); err != nil {
+		return diag.FromErr(err)
+	}
+	// Only read the config tracked in Terraform
+	config := d.Get("config").(map[string]interface{})
+	for k := range config {
+		if value, exists := cloud.Config[k]; exists {
+			config[k] = juju.ConfigEntryToString(value)
+		} else {
+			delete(config, k)
+		}
+	}
+	if err = d.Set("config", config); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceCloudUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*juju.Client)
+
+	if !d.HasChangesExcept("force") {
+		return nil
+	}
+
+	err := client.Clouds.UpdateCloud(juju.UpdateCloudInput{
+		Cloud: cloudFromResourceData(d),
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceCloudRead(ctx, d, meta)
+}
+
+// Juju refers to cloud deletion as "remove" so we call the Destroy function of our client here rather than delete
+// This function remains named Delete for parity across the provider and to stick within terraform naming conventions
+func resourceCloudDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*juju.Client)
+
+	err := client.Clouds.DestroyCloud(juju.DestroyCloudInput{
+		Name: d.Id(),
+	})
+	if err != nil && !errors.Is(err, errors.NotFound) {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func cloudFromResourceData(d *schema.ResourceData) jujucloud.Cloud {
+	cloud := jujucloud.Cloud{
+		Name:             d.Get("name").(string),
+		Type:             d.Get("type").(string),
+		Endpoint:         d.Get("endpoint").(string),
+		IdentityEndpoint: d.Get("identity_endpoint").(string),
+		StorageEndpoint:  d.Get("storage_endpoint").(string),
+	}
+	for _, authType := range d.Get("auth_types").(*schema.Set).List() {
+		cloud.AuthTypes = append(cloud.AuthTypes, jujucloud.AuthType(authType.(string)))
+	}
+	for _, r := range d.Get("regions").([]interface{}) {
+		region := r.(map[string]interface{})
+		cloud.Regions = append(cloud.Regions, jujucloud.Region{
+			Name:             region["name"].(string),
+			Endpoint:         region["endpoint"].(string),
+			IdentityEndpoint: region["identity_endpoint"].(string),
+			StorageEndpoint:  region["storage_endpoint"].(string),
+		})
+	}
+	for _, cert := range d.Get("ca_certificates").([]interface{}) {
+		cloud.CACertificates = append(cloud.CACertificates, cert.(string))
+	}
+	if config := d.Get("config").(map[string]interface{}); len(config) > 0 {
+		cloud.Config = config
+	}
+	return cloud
+}
+
+func flattenCloudRegions(regions []jujucloud.Region) []map[string]interface{} {
+	flattened := make([]map[string]interface{}, 0, len(regions))
+	for _, region := range regions {
+		flattened = append(flattened, map[string]interface{}{
+			"name":              region.Name,
+			"endpoint":          region.Endpoint,
+			"identity_endpoint": region.IdentityEndpoint,
+			"storage_endpoint":  region.StorageEndpoint,
+		})
+	}
+	return flattened
+}
+
+func cloudAuthTypes(authTypes jujucloud.AuthTypes) []string {
+	names := make([]string, 0, len(authTypes))
+	for _, authType := range authTypes {
+		names = append(names, string(authType))
+	}
+	return names
+}
@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAcc_ResourceCloud_Basic(t *testing.T) {
+	cloudName := acctest.RandomWithPrefix("tf-test-cloud")
+
+	resourceName := "juju_cloud.this"
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceCloud(cloudName, "https://10.20.30.40:8443"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", cloudName),
+					resource.TestCheckResourceAttr(resourceName, "type", "lxd"),
+					resource.TestCheckResourceAttr(resourceName, "auth_types.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "endpoint", "https://10.20.30.40:8443"),
+					resource.TestCheckResourceAttr(resourceName, "regions.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "regions.0.name", "default"),
+				),
+			},
+			{
+				Config: testAccResourceCloud(cloudName, "https://10.20.30.41:8443"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "endpoint", "https://10.20.30.41:8443"),
+				),
+			},
+			{
+				ImportStateVerify:       true,
+				ImportState:             true,
+				ImportStateVerifyIgnore: []string{"force"},
+				ResourceName:            resourceName,
+			},
+		},
+	})
+}
+
+func testAccResourceCloud(cloudName, endpoint string) string {
+	return fmt.Sprintf(`
+resource "juju_cloud" "this" {
+  name       = %q
+  type       = "lxd"
+  auth_types = ["certificate"]
+  endpoint   = %q
+
+  regions {
+    name = "default"
+  }
+}`, cloudName, endpoint)
+}
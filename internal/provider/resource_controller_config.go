@@ -0,0 +1,137 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/juju/terraform-provider-juju/internal/juju"
+)
+
+func resourceControllerConfig() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "A resource that represents the configuration of the Juju controller the provider is connected to. " +
+			"Juju does not tell set values apart from its defaults, so importing the resource adopts no configuration: " +
+			"the keys in `config` are managed from the next apply.",
+
+		CreateContext: resourceControllerConfigCreate,
+		ReadContext:   resourceControllerConfigRead,
+		UpdateContext: resourceControllerConfigUpdate,
+		DeleteContext: resourceControllerConfigDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"config": {
+				Description: "Controller configuration. Juju does not support unsetting controller configuration, keys removed from this map keep their current value",
+				Type:        schema.TypeMap,
+				Required:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceControllerConfigCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*juju.Client)
+
+	config := d.Get("config").(map[string]interface{})
+
+	err := client.Controllers.UpdateControllerConfig(juju.UpdateControllerConfigInput{
+		Config: config,
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	response, err := client.Controllers.ReadControllerConfig()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(response.ControllerUUID)
+
+	return resourceControllerConfigRead(ctx, d, meta)
+}
+
+func resourceControllerConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*juju.Client)
+
+	var diags diag.Diagnostics
+
+	response, err := client.Controllers.ReadControllerConfig()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if response.ControllerUUID != d.Id() {
+		return diag.Errorf("the provider is connected to controller %s, not to controller %s", response.ControllerUUID, d.Id())
+	}
+
+	// Only read the controller configuration tracked in Terraform
+	config := d.Get("config").(map[string]interface{})
+	for k := range config {
+		if value, exists := response.Config[k]; exists {
+			config[k] = juju.ConfigEntryToString(value)
+		} else {
+			delete(config, k)
+		}
+	}
+	if err = d.Set("config", config); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceControllerConfigUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*juju.Client)
+
+	var diags diag.Diagnostics
+
+	if !d.HasChange("config") {
+		return diags
+	}
+
+	oldConfig, newConfig := d.GetChange("config")
+	newConfigMap := newConfig.(map[string]interface{})
+
+	err := client.Controllers.UpdateControllerConfig(juju.UpdateControllerConfigInput{
+		Config: newConfigMap,
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return append(diags, controllerConfigUnsetWarning(getUnsetConfigKeys(oldConfig.(map[string]interface{}), newConfigMap))...)
+}
+
+// resourceControllerConfigDelete only removes the resource from the state,
+// the controller keeps its configuration.
+func resourceControllerConfigDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	config := d.Get("config").(map[string]interface{})
+	diags = append(diags, controllerConfigUnsetWarning(getUnsetConfigKeys(config, nil))...)
+
+	d.SetId("")
+
+	return diags
+}
+
+// controllerConfigUnsetWarning warns about controller configuration keys
+// that are no longer managed but cannot be unset.
+func controllerConfigUnsetWarning(keys []string) diag.Diagnostics {
+	if len(keys) == 0 {
+		return nil
+	}
+	return diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  "Controller configuration cannot be unset",
+		Detail:   fmt.Sprintf("Juju does not support unsetting controller configuration. The following keys are no longer managed by Terraform and keep their current value: %s", strings.Join(keys, ", ")),
+	}}
+}
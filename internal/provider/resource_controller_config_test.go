@@ -0,0 +1,55 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAcc_ResourceControllerConfig_Basic(t *testing.T) {
+	resourceName := "juju_controller_config.this"
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceControllerConfig(t, "12h0m0s"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "config.max-debug-log-duration", "12h0m0s"),
+				),
+			},
+			{
+				// restore the Juju default
+				Config: testAccResourceControllerConfig(t, "24h0m0s"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "config.max-debug-log-duration", "24h0m0s"),
+				),
+			},
+			{
+				// the import adopts no configuration
+				ImportState:  true,
+				ResourceName: resourceName,
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					if len(states) != 1 {
+						return fmt.Errorf("expected 1 imported resource, got %d", len(states))
+					}
+					if n := states[0].Attributes["config.%"]; n != "" && n != "0" {
+						return fmt.Errorf("expected no imported configuration, got %s keys", n)
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func testAccResourceControllerConfig(t *testing.T, duration string) string {
+	return fmt.Sprintf(`
+resource "juju_controller_config" "this" {
+  config = {
+    max-debug-log-duration = %q
+  }
+}`, duration)
+}
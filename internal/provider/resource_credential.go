@@ -2,7 +2,12 @@ package provider
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
 	"fmt"
+	"os"
+	"reflect"
 	"strconv"
 	"strings"
 
@@ -19,6 +24,7 @@ func resourceCredential() *schema.Resource {
 		ReadContext:   resourceCredentialRead,
 		UpdateContext: resourceCredentialUpdate,
 		DeleteContext: resourceCredentialDelete,
+		CustomizeDiff: resourceCredentialCustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceCredentialImporter,
 		},
@@ -42,11 +48,24 @@ func resourceCredential() *schema.Resource {
 				},
 			},
 			"attributes": {
-				Description: "Credential attributes accordingly to the cloud",
+				Description: "Credential attributes accordingly to the cloud. Attributes are checked against the credential schema of the cloud at plan time for the kubernetes, lxd, openstack, maas, ec2 and gce clouds. The attributes of the other clouds, e.g. azure, vsphere, oci or equinix, are not validated by the provider",
 				Type:        schema.TypeMap,
 				Optional:    true,
+				Sensitive:   true,
 				Elem:        &schema.Schema{Type: schema.TypeString, Optional: true},
 			},
+			"attribute_files": {
+				Description: "Credential attributes read from local files, mapping the name of the attribute to the path of the file. The content of the files is not stored in the state",
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"attribute_files_sha256": {
+				Description: "The SHA-256 checksums of the files of `attribute_files`, used to detect changes to their content",
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
 			"auth_type": {
 				Description: "Credential authorization type",
 				Type:        schema.TypeString,
@@ -64,6 +83,17 @@ func resourceCredential() *schema.Resource {
 				Optional:    true,
 				Default:     true,
 			},
+			"force": {
+				Description: "Revoke the controller credential on destroy even when models still use it",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"replaces": {
+				Description: "The name of a controller credential of the same cloud to rotate. The models using it are changed to use this credential, so the replaced credential can then be destroyed",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
 			"name": {
 				Description: "The name to be assigned to the credential",
 				Type:        schema.TypeString,
@@ -103,8 +133,10 @@ func resourceCredentialCreate(ctx context.Context, d *schema.ResourceData, meta
 	for key, value := range attributesRaw {
 		attributes[key] = AttributeEntryToString(value)
 	}
+	attributeFiles := credentialAttributeFiles(d)
 	response, err := client.Credentials.CreateCredential(juju.CreateCredentialInput{
 		Attributes:           attributes,
+		AttributeFiles:       attributeFiles,
 		AuthType:             authType,
 		ClientCredential:     clientCredential,
 		CloudList:            cloud,
@@ -118,6 +150,14 @@ func resourceCredentialCreate(ctx context.Context, d *schema.ResourceData, meta
 	id := fmt.Sprintf("%s:%s:%t:%t", credentialName, response.CloudName, clientCredential, controllerCredential)
 	d.SetId(id)
 
+	if err := setAttributeFilesSHA256(d, attributeFiles); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if replaces := d.Get("replaces").(string); replaces != "" && controllerCredential {
+		diags = append(diags, replaceModelCredential(client, response.CloudName, replaces, credentialName)...)
+	}
+
 	return diags
 }
 
@@ -152,6 +192,15 @@ func resourceCredentialRead(ctx context.Context, d *schema.ResourceData, meta in
 		return diag.FromErr(err)
 	}
 
+	if !controllerCredential && !client.Credentials.ClientCredentialsReadable() {
+		// the client credential store only holds the credentials of
+		// this run, the credential is kept as is
+		if err := d.Set("name", credentialName); err != nil {
+			return diag.FromErr(err)
+		}
+		return diags
+	}
+
 	response, err := client.Credentials.ReadCredential(juju.ReadCredentialInput{
 		ClientCredential:     clientCredential,
 		CloudName:            cloudName,
@@ -194,7 +243,7 @@ func resourceCredentialUpdate(ctx context.Context, d *schema.ResourceData, meta
 	}
 	credentialName, cloudName := id[0], id[1]
 
-	if !d.HasChange("auth_type") && !d.HasChange("client_credential") && !d.HasChange("controller_credential") && !d.HasChange("attributes") {
+	if !d.HasChange("auth_type") && !d.HasChange("client_credential") && !d.HasChange("controller_credential") && !d.HasChanges("attributes", "attribute_files", "attribute_files_sha256", "replaces") {
 		// no changes
 		return diags
 	}
@@ -208,8 +257,11 @@ func resourceCredentialUpdate(ctx context.Context, d *schema.ResourceData, meta
 		newAttributes[key] = AttributeEntryToString(value)
 	}
 
+	attributeFiles := credentialAttributeFiles(d)
+
 	err := client.Credentials.UpdateCredential(juju.UpdateCredentialInput{
 		Attributes:           newAttributes,
+		AttributeFiles:       attributeFiles,
 		AuthType:             newAuthType,
 		ClientCredential:     newClientCredential,
 		CloudName:            cloudName,
@@ -223,12 +275,42 @@ func resourceCredentialUpdate(ctx context.Context, d *schema.ResourceData, meta
 	newID := fmt.Sprintf("%s:%s:%t:%t", credentialName, cloudName, newClientCredential, newControllerCredential)
 	d.SetId(newID)
 
+	if err := setAttributeFilesSHA256(d, attributeFiles); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if replaces := d.Get("replaces").(string); d.HasChange("replaces") && replaces != "" && newControllerCredential {
+		diags = append(diags, replaceModelCredential(client, cloudName, replaces, credentialName)...)
+	}
+
 	return diags
 }
 
+// replaceModelCredential changes the models using the replaced
+// credential to use the credential instead.
+func replaceModelCredential(client *juju.Client, cloudName, replaced, credentialName string) diag.Diagnostics {
+	response, err := client.Credentials.ReplaceModelCredential(juju.ReplaceModelCredentialInput{
+		CloudName:   cloudName,
+		Name:        replaced,
+		Replacement: credentialName,
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if len(response.Models) == 0 {
+		return nil
+	}
+	return diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  fmt.Sprintf("Models moved from credential %s to %s", replaced, credentialName),
+		Detail:   fmt.Sprintf("The following models now use credential %s: %s", credentialName, strings.Join(response.Models, ", ")),
+	}}
+}
+
 func resourceCredentialDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// When removing cloud credential from a controller, Juju performs additional
-	// checks to ensure that there are no models using this credential. The provider will not force the removal
+	// checks to ensure that there are no models using this credential. The provider
+	// refuses to revoke a credential used by models unless `force` is set
 	client := meta.(*juju.Client)
 	var diags diag.Diagnostics
 
@@ -247,7 +329,16 @@ func resourceCredentialDelete(ctx context.Context, d *schema.ResourceData, meta
 		CloudName:            cloudName,
 		ControllerCredential: controllerCredential,
 		Name:                 credentialName,
+		Force:                d.Get("force").(bool),
 	})
+	var inUseErr *juju.CredentialInUseError
+	if errors.As(err, &inUseErr) {
+		return append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Credential %s is still used by %d model(s)", credentialName, len(inUseErr.Models)),
+			Detail:   fmt.Sprintf("The following models use the credential: %s. Rotate them to another credential with `replaces`, or set `force` to revoke the credential anyway.", strings.Join(inUseErr.Models, ", ")),
+		})
+	}
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -257,6 +348,92 @@ func resourceCredentialDelete(ctx context.Context, d *schema.ResourceData, meta
 	return diags
 }
 
+// resourceCredentialCustomizeDiff plans an update when the content of
+// the attribute files changed, and checks the attributes against the
+// credential schema of the cloud so mistakes fail at plan time.
+func resourceCredentialCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("attribute_files") || !d.NewValueKnown("attributes") || !d.NewValueKnown("auth_type") {
+		return nil
+	}
+
+	attributeFiles := make(map[string]string)
+	for name, path := range d.Get("attribute_files").(map[string]interface{}) {
+		attributeFiles[name] = path.(string)
+	}
+	hashes, err := attributeFilesSHA256(attributeFiles)
+	if err != nil {
+		return err
+	}
+	oldHashes := d.Get("attribute_files_sha256").(map[string]interface{})
+	if !reflect.DeepEqual(hashesToInterfaceMap(hashes), oldHashes) {
+		if err := d.SetNew("attribute_files_sha256", hashes); err != nil {
+			return err
+		}
+	}
+
+	cloud := d.Get("cloud").([]interface{})
+	if meta == nil || len(cloud) == 0 || cloud[0] == nil || !d.NewValueKnown("cloud.0.name") {
+		return nil
+	}
+	if d.Id() != "" && !d.HasChanges("attributes", "attribute_files", "auth_type") {
+		return nil
+	}
+
+	var names []string
+	for name := range d.Get("attributes").(map[string]interface{}) {
+		names = append(names, name)
+	}
+	for name := range attributeFiles {
+		names = append(names, name)
+	}
+
+	client := meta.(*juju.Client)
+	return client.Credentials.ValidateCredentialAttributes(juju.ValidateCredentialAttributesInput{
+		CloudName:  cloud[0].(map[string]interface{})["name"].(string),
+		AuthType:   d.Get("auth_type").(string),
+		Attributes: names,
+	})
+}
+
+func credentialAttributeFiles(d *schema.ResourceData) map[string]string {
+	attributeFiles := make(map[string]string)
+	for name, path := range d.Get("attribute_files").(map[string]interface{}) {
+		attributeFiles[name] = path.(string)
+	}
+	return attributeFiles
+}
+
+// attributeFilesSHA256 returns the SHA-256 checksum of the content of
+// each attribute file.
+func attributeFilesSHA256(attributeFiles map[string]string) (map[string]string, error) {
+	hashes := make(map[string]string, len(attributeFiles))
+	for name, path := range attributeFiles {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("reading file for attribute %q: %w", name, err)
+		}
+		sum := sha256.Sum256(data)
+		hashes[name] = hex.EncodeToString(sum[:])
+	}
+	return hashes, nil
+}
+
+func setAttributeFilesSHA256(d *schema.ResourceData, attributeFiles map[string]string) error {
+	hashes, err := attributeFilesSHA256(attributeFiles)
+	if err != nil {
+		return err
+	}
+	return d.Set("attribute_files_sha256", hashes)
+}
+
+func hashesToInterfaceMap(hashes map[string]string) map[string]interface{} {
+	result := make(map[string]interface{}, len(hashes))
+	for name, hash := range hashes {
+		result[name] = hash
+	}
+	return result
+}
+
 func convertOptionsBool(clientCredentialStr, controllerCredentialStr string) (bool, bool, error) {
 	clientCredentialBool, err := strconv.ParseBool(clientCredentialStr)
 	if err != nil {
@@ -271,7 +448,32 @@ func convertOptionsBool(clientCredentialStr, controllerCredentialStr string) (bo
 	return clientCredentialBool, controllerCredentialBool, nil
 }
 
-// TODO
+// resourceCredentialImporter accepts the resource ID, or the
+// cloud/owner/name ID of a controller credential of the current user.
 func resourceCredentialImporter(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if !strings.Contains(d.Id(), "/") {
+		return []*schema.ResourceData{d}, nil
+	}
+
+	id := strings.Split(d.Id(), "/")
+	if len(id) != 3 {
+		return nil, fmt.Errorf("unable to parse credential ID %q, expected cloud/owner/name", d.Id())
+	}
+	cloudName, owner, credentialName := id[0], id[1], id[2]
+
+	client := meta.(*juju.Client)
+	response, err := client.Credentials.ReadControllerCredential(juju.ReadControllerCredentialInput{
+		CloudName: cloudName,
+		Name:      credentialName,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if response.Owner != owner {
+		return nil, fmt.Errorf("unable to import credential %s, only credentials of the current user %s can be imported", d.Id(), response.Owner)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s:%t:%t", credentialName, cloudName, false, true))
+
 	return []*schema.ResourceData{d}, nil
 }
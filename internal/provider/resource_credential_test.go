@@ -2,11 +2,16 @@ package provider
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
 	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/juju/terraform-provider-juju/internal/juju"
 )
 
 func TestAcc_ResourceCredential_Basic(t *testing.T) {
@@ -25,26 +30,31 @@ func TestAcc_ResourceCredential_Basic(t *testing.T) {
 				// Mind that ExpectError should be the first step
 				// "When tests have an ExpectError[...]; this results in any previous state being cleared. "
 				// https://github.com/hashicorp/terraform-plugin-sdk/issues/118
-				Config:      testAccResourceCredential(t, credentialName, authTypeInvalid),
+				Config:      testAccResourceCredential(t, credentialName, authTypeInvalid, "key-1"),
 				ExpectError: regexp.MustCompile(fmt.Sprintf("Error: supported auth-types (.*), \"%s\" not supported", authTypeInvalid)),
 			},
 			{
-				Config:      testAccResourceCredential(t, credentialInvalidName, authType),
+				Config:      testAccResourceCredential(t, credentialInvalidName, authType, "key-1"),
 				ExpectError: regexp.MustCompile(fmt.Sprintf("Error: \"%s\" is not a valid credential name", credentialInvalidName)),
 			},
 			{
-				Config: testAccResourceCredential(t, credentialName, authType),
+				// the lxd certificate credential has no token attribute
+				Config:      testAccResourceCredentialToken(t, credentialName, authType, token),
+				ExpectError: regexp.MustCompile("missing attributes server-cert, client-cert, client-key; unknown attributes token"),
+			},
+			{
+				Config: testAccResourceCredential(t, credentialName, authType, "key-1"),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(resourceName, "name", credentialName),
 					resource.TestCheckResourceAttr(resourceName, "auth_type", authType),
 				),
 			},
 			{
-				Config: testAccResourceCredentialToken(t, credentialName, authType, token),
+				Config: testAccResourceCredential(t, credentialName, authType, "key-2"),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(resourceName, "name", credentialName),
 					resource.TestCheckResourceAttr(resourceName, "auth_type", authType),
-					resource.TestCheckResourceAttr(resourceName, "attributes.token", token),
+					resource.TestCheckResourceAttr(resourceName, "attributes.client-key", "key-2"),
 				),
 			},
 			{
@@ -52,15 +62,34 @@ func TestAcc_ResourceCredential_Basic(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerifyIgnore: []string{
 					"attributes.%",
-					"attributes.token"},
+					"attributes.client-cert",
+					"attributes.client-key",
+					"attributes.server-cert",
+					"attribute_files_sha256.%",
+					"force"},
 				ImportStateId: fmt.Sprintf("%s:localhost:false:true", credentialName),
 				ResourceName:  resourceName,
 			},
+			{
+				ImportStateVerify: true,
+				ImportState:       true,
+				ImportStateVerifyIgnore: []string{
+					"attributes.%",
+					"attributes.client-cert",
+					"attributes.client-key",
+					"attributes.server-cert",
+					"attribute_files_sha256.%",
+					"force"},
+				ImportStateId: fmt.Sprintf("localhost/admin/%s", credentialName),
+				ResourceName:  resourceName,
+			},
 		},
 	})
 }
 
-func testAccResourceCredential(t *testing.T, credentialName string, authType string) string {
+// testAccResourceCredential returns a certificate credential of the
+// localhost lxd cloud, with placeholder certificates.
+func testAccResourceCredential(t *testing.T, credentialName, authType, clientKey string) string {
 	return fmt.Sprintf(`
 resource "juju_credential" "credential" {
   name = %q
@@ -70,7 +99,13 @@ resource "juju_credential" "credential" {
   }
 
   auth_type = "%s"
-}`, credentialName, authType)
+
+  attributes = {
+	client-cert = "client-cert"
+	client-key  = %q
+	server-cert = "server-cert"
+  }
+}`, credentialName, authType, clientKey)
 }
 
 func testAccResourceCredentialToken(t *testing.T, credentialName, authType, token string) string {
@@ -89,3 +124,243 @@ resource "juju_credential" "credential" {
   }
 }`, credentialName, authType, token)
 }
+
+func TestAcc_ResourceCredential_Rotate(t *testing.T) {
+	oldCredentialName := acctest.RandomWithPrefix("tf-test-credential-old")
+	newCredentialName := acctest.RandomWithPrefix("tf-test-credential-new")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceCredentialRotate(oldCredentialName, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("juju_credential.old", "force", "true"),
+				),
+			},
+			{
+				Config: testAccResourceCredentialRotate(oldCredentialName, newCredentialName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("juju_credential.new", "replaces", oldCredentialName),
+				),
+			},
+			{
+				ImportStateVerify: true,
+				ImportState:       true,
+				ImportStateVerifyIgnore: []string{
+					"attributes.%",
+					"attributes.client-cert",
+					"attributes.client-key",
+					"attributes.server-cert",
+					"attribute_files_sha256.%",
+					"force",
+					"replaces"},
+				ImportStateId: fmt.Sprintf("localhost/admin/%s", newCredentialName),
+				ResourceName:  "juju_credential.new",
+			},
+			{
+				// the replaced credential is revoked once rotated
+				Config: testAccResourceCredentialRotate("", newCredentialName),
+				Check: func(s *terraform.State) error {
+					client := Provider.Meta().(*juju.Client)
+					_, err := client.Credentials.ReadControllerCredential(juju.ReadControllerCredentialInput{
+						CloudName: "localhost",
+						Name:      oldCredentialName,
+					})
+					if err == nil {
+						return fmt.Errorf("credential %s was not revoked", oldCredentialName)
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+// testAccResourceCredentialRotate returns the old credential, revoked
+// with force, and the new credential replacing it. An empty name leaves
+// the credential out.
+func testAccResourceCredentialRotate(oldCredentialName, newCredentialName string) string {
+	config := ""
+	if oldCredentialName != "" {
+		config += fmt.Sprintf(`
+resource "juju_credential" "old" {
+  name  = %q
+  force = true
+
+  cloud {
+   name   = "localhost"
+  }
+
+  auth_type = "certificate"
+
+  attributes = {
+	client-cert = "client-cert"
+	client-key  = "old-client-key"
+	server-cert = "server-cert"
+  }
+}
+`, oldCredentialName)
+	}
+	if newCredentialName != "" {
+		config += fmt.Sprintf(`
+resource "juju_credential" "new" {
+  name     = %q
+  replaces = %q
+
+  cloud {
+   name   = "localhost"
+  }
+
+  auth_type = "certificate"
+
+  attributes = {
+	client-cert = "client-cert"
+	client-key  = "new-client-key"
+	server-cert = "server-cert"
+  }
+}
+`, newCredentialName, oldCredentialName)
+	}
+	return config
+}
+
+func TestAcc_ResourceCredential_AttributeFiles(t *testing.T) {
+	credentialName := acctest.RandomWithPrefix("tf-test-credential")
+	dir := t.TempDir()
+	attributeFiles := map[string]string{}
+	for _, name := range []string{"client-cert", "client-key", "server-cert"} {
+		attributeFiles[name] = filepath.Join(dir, name)
+		if err := os.WriteFile(attributeFiles[name], []byte(name), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	resourceName := "juju_credential.credential"
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceCredentialAttributeFiles(credentialName, attributeFiles),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "attribute_files.client-key", attributeFiles["client-key"]),
+					// sha256 of "client-key"
+					resource.TestCheckResourceAttr(resourceName, "attribute_files_sha256.client-key", "8eb943e7040b69a94bf39562088223755bff4c2e7c5fc257f1e08f870fe01d35"),
+				),
+			},
+			{
+				PreConfig: func() {
+					if err := os.WriteFile(attributeFiles["client-key"], []byte("rotated-client-key"), 0600); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccResourceCredentialAttributeFiles(credentialName, attributeFiles),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "attribute_files_sha256.client-key", "a93216bad51f192f31a079b4434ed8334eaab9642af4aa592850f4c43b9f3b89"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceCredentialAttributeFiles(credentialName string, attributeFiles map[string]string) string {
+	return fmt.Sprintf(`
+resource "juju_credential" "credential" {
+  name = %q
+
+  cloud {
+   name   = "localhost"
+  }
+
+  auth_type = "certificate"
+
+  attribute_files = {
+	client-cert = %q
+	client-key  = %q
+	server-cert = %q
+  }
+}`, credentialName, attributeFiles["client-cert"], attributeFiles["client-key"], attributeFiles["server-cert"])
+}
+
+func TestAcc_ResourceCredential_ClientCredential(t *testing.T) {
+	credentialName := acctest.RandomWithPrefix("tf-test-credential")
+
+	resourceName := "juju_credential.credential"
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceCredentialClient(credentialName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "client_credential", "true"),
+					func(s *terraform.State) error {
+						path := filepath.Join(os.Getenv(JujuClientCredentialStoreEnvKey), "credentials.yaml")
+						data, err := os.ReadFile(path)
+						if err != nil {
+							return err
+						}
+						if !strings.Contains(string(data), credentialName) {
+							return fmt.Errorf("credential %s not found in %s", credentialName, path)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_ResourceCredential_ClientCredentialMemoryStore(t *testing.T) {
+	credentialName := acctest.RandomWithPrefix("tf-test-credential")
+	t.Setenv(JujuClientCredentialStoreEnvKey, juju.ClientStoreMemory)
+
+	resourceName := "juju_credential.credential"
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceCredentialClient(credentialName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "client_credential", "true"),
+				),
+			},
+			{
+				// a second run starts with an empty memory store
+				Config: testAccResourceCredentialClient(credentialName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", credentialName),
+					resource.TestCheckResourceAttr(resourceName, "auth_type", "certificate"),
+				),
+			},
+			{
+				Config:   testAccResourceCredentialClient(credentialName),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func testAccResourceCredentialClient(credentialName string) string {
+	return fmt.Sprintf(`
+resource "juju_credential" "credential" {
+  name = %q
+
+  cloud {
+   name   = "localhost"
+  }
+
+  auth_type             = "certificate"
+  client_credential     = true
+  controller_credential = false
+
+  attributes = {
+	client-cert = "client-cert"
+	client-key  = "client-key"
+	server-cert = "server-cert"
+  }
+}`, credentialName)
+}
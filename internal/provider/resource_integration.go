@@ -3,6 +3,8 @@ package provider
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -24,44 +26,48 @@ func resourceIntegration() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{{
+			Version: 0,
+			Type:    resourceIntegrationV0().CoreConfigSchema().ImpliedType(),
+			Upgrade: resourceIntegrationStateUpgradeV0,
+		}},
+
+		Schema: integrationSchema(),
+	}
+}
+
+// resourceIntegrationV0 is the integration resource identified by
+// "<model>:<application>:<endpoint>:<application>:<endpoint>".
+func resourceIntegrationV0() *schema.Resource {
+	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"model": {
-				Description: "The name of the model to operate in.",
-				Type:        schema.TypeString,
-				Required:    true,
+				Type:     schema.TypeString,
+				Required: true,
 			},
 			"via": {
-				Description: "A comma separated list of CIDRs for outbound traffic.",
-				Type:        schema.TypeString,
-				Optional:    true,
+				Type:     schema.TypeString,
+				Optional: true,
 			},
 			"application": {
-				Description: "The two applications to integrate.",
-				Type:        schema.TypeSet,
-				Required:    true,
-				MaxItems:    2,
-				MinItems:    2,
+				Type:     schema.TypeSet,
+				Required: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"name": {
-							Description: "The name of the application.",
-							Type:        schema.TypeString,
-							Optional:    true,
+							Type:     schema.TypeString,
+							Optional: true,
 						},
 						"endpoint": {
-							Description: "The endpoint name.",
-							Type:        schema.TypeString,
-							Optional:    true,
-							Computed:    true,
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
 						},
-						//TODO: find an alternative to setting Computed: true in `offer_url`
-						//`offer_url` has the property `Computed` set to true even though it will never be computed.
-						//This is due to an issue with the plugin-sdk/v2 and `schema.TypeSet` meaning that a plan will always show needed changes despite the read op storing the correct state
 						"offer_url": {
-							Description: "The URL of a remote application.",
-							Type:        schema.TypeString,
-							Optional:    true,
-							Computed:    true,
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
 						},
 					},
 				},
@@ -70,13 +76,86 @@ func resourceIntegration() *schema.Resource {
 	}
 }
 
+func integrationSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"model": {
+			Description: "The name of the model to operate in, qualified with its owner as `owner/name` for models owned by other users.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"via": {
+			Description: "A comma separated list of CIDRs for outbound traffic.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"wait_for_status": {
+			Description: "Wait for the integration to be joined after creating or updating it, or to be suspended when `suspended` is set.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+		"suspended": {
+			Description: "Whether the integration is suspended. Suspending a cross model integration cuts off the consumer without destroying the integration.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+		"suspended_reason": {
+			Description: "The reason for suspending the integration, reported in its status.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"relation_id": {
+			Description: "The Juju relation id of the integration.",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+		"status": {
+			Description: "The status of the integration.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"application": {
+			Description: "The two applications to integrate.",
+			Type:        schema.TypeSet,
+			Required:    true,
+			MaxItems:    2,
+			MinItems:    2,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Description: "The name of the application.",
+						Type:        schema.TypeString,
+						Optional:    true,
+					},
+					"endpoint": {
+						Description: "The endpoint name.",
+						Type:        schema.TypeString,
+						Optional:    true,
+						Computed:    true,
+					},
+					//TODO: find an alternative to setting Computed: true in `offer_url`
+					//`offer_url` has the property `Computed` set to true even though it will never be computed.
+					//This is due to an issue with the plugin-sdk/v2 and `schema.TypeSet` meaning that a plan will always show needed changes despite the read op storing the correct state
+					"offer_url": {
+						Description: "The URL of a remote application. The offer is consumed and removed along with the integration, use a `juju_saas` resource and its name instead to share a consumed offer between integrations.",
+						Type:        schema.TypeString,
+						Optional:    true,
+						Computed:    true,
+					},
+				},
+			},
+		},
+	}
+}
+
 func resourceIntegrationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*juju.Client)
 
 	modelName := d.Get("model").(string)
 	modelUUID, err := client.Models.ResolveModelUUID(modelName)
 	if err != nil {
-		return diag.FromErr(err)
+		return checkModelErr(err)
 	}
 
 	apps := d.Get("application").(*schema.Set).List()
@@ -112,20 +191,31 @@ func resourceIntegrationCreate(ctx context.Context, d *schema.ResourceData, meta
 		return diag.FromErr(err)
 	}
 
-	applications := parseApplications(response.Applications)
+	applications := parseApplications(response.Applications, saasApplicationNames(d))
 
-	id := generateID(modelName, response.Applications)
+	id := generateID(modelName, response.ID, response.Applications)
 	if err := d.Set("application", applications); err != nil {
 		return diag.FromErr(err)
 	}
 
 	d.SetId(id)
 
-	return diag.Diagnostics{}
+	if d.Get("suspended").(bool) {
+		if err := setIntegrationSuspended(d, client, modelUUID); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if err := waitForIntegrationStatus(d, client, modelUUID); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceIntegrationRead(ctx, d, meta)
 }
 
 func IsIntegrationNotFound(err error) bool {
-	return strings.Contains(err.Error(), "no integrations exist")
+	return strings.Contains(err.Error(), "no integrations exist") ||
+		strings.Contains(err.Error(), "integration not found")
 }
 
 func handleIntegrationNotFoundError(err error, d *schema.ResourceData, resource string) diag.Diagnostics {
@@ -141,21 +231,20 @@ func handleIntegrationNotFoundError(err error, d *schema.ResourceData, resource
 func resourceIntegrationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*juju.Client)
 
-	id := strings.Split(d.Id(), ":")
-
-	modelName := id[0]
+	modelName, relationID, endpoints, err := parseIntegrationID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
 	modelID, err := client.Models.ResolveModelUUID(modelName)
 	if err != nil {
-		return diag.FromErr(err)
+		return checkModelErr(err)
 	}
 
 	int := &juju.IntegrationInput{
-		ModelUUID: modelID,
-		Endpoints: []string{
-			fmt.Sprintf("%v:%v", id[1], id[2]),
-			fmt.Sprintf("%v:%v", id[3], id[4]),
-		},
+		ModelUUID:  modelID,
+		RelationID: relationID,
+		Endpoints:  endpoints,
 	}
 
 	response, err := client.Integrations.ReadIntegration(int)
@@ -163,7 +252,10 @@ func resourceIntegrationRead(ctx context.Context, d *schema.ResourceData, meta i
 		return handleIntegrationNotFoundError(err, d, d.Id())
 	}
 
-	applications := parseApplications(response.Applications)
+	applications := parseApplications(response.Applications, saasApplicationNames(d))
+
+	// imported and previous IDs are replaced by the current format
+	d.SetId(generateID(modelName, response.ID, response.Applications))
 
 	if err := d.Set("model", modelName); err != nil {
 		return diag.FromErr(err)
@@ -173,19 +265,57 @@ func resourceIntegrationRead(ctx context.Context, d *schema.ResourceData, meta i
 		return diag.FromErr(err)
 	}
 
+	if err := d.Set("relation_id", response.ID); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("status", response.Status); err != nil {
+		return diag.FromErr(err)
+	}
+
+	suspended := response.Status == "suspended" || response.Status == "suspending"
+	if err := d.Set("suspended", suspended); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return diag.Diagnostics{}
 }
 
 func resourceIntegrationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	var diags diag.Diagnostics
 	client := meta.(*juju.Client)
 
 	modelName := d.Get("model").(string)
 	modelUUID, err := client.Models.ResolveModelUUID(modelName)
 	if err != nil {
+		return checkModelErr(err)
+	}
+
+	if d.HasChanges("application", "via") {
+		if diags := updateIntegrationApplications(d, client, modelName, modelUUID); diags.HasError() {
+			return diags
+		}
+	}
+
+	suspended := d.Get("suspended").(bool)
+	if d.HasChange("suspended") || (suspended && d.HasChange("suspended_reason")) {
+		if err := setIntegrationSuspended(d, client, modelUUID); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if err := waitForIntegrationStatus(d, client, modelUUID); err != nil {
 		return diag.FromErr(err)
 	}
 
+	return resourceIntegrationRead(ctx, d, meta)
+}
+
+// updateIntegrationApplications replaces the integration with one
+// between the new applications.
+func updateIntegrationApplications(d *schema.ResourceData, client *juju.Client, modelName string, modelUUID string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var err error
+
 	var old, new interface{}
 	var oldEndpoints, endpoints []string
 	var oldOfferURL, offerURL *string
@@ -244,9 +374,9 @@ func resourceIntegrationUpdate(ctx context.Context, d *schema.ResourceData, meta
 		return diag.FromErr(err)
 	}
 
-	applications := parseApplications(response.Applications)
+	applications := parseApplications(response.Applications, saasApplicationNames(d))
 
-	id := generateID(modelName, response.Applications)
+	id := generateID(modelName, response.ID, response.Applications)
 	if err := d.Set("application", applications); err != nil {
 		return diag.FromErr(err)
 	}
@@ -256,6 +386,52 @@ func resourceIntegrationUpdate(ctx context.Context, d *schema.ResourceData, meta
 	return diags
 }
 
+// setIntegrationSuspended suspends or resumes the integration as set
+// in the plan.
+func setIntegrationSuspended(d *schema.ResourceData, client *juju.Client, modelUUID string) error {
+	_, relationID, _, err := parseIntegrationID(d.Id())
+	if err != nil {
+		return err
+	}
+	if relationID == nil {
+		return fmt.Errorf("unable to find the relation id in integration ID %q", d.Id())
+	}
+
+	return client.Integrations.SetIntegrationSuspended(&juju.SetIntegrationSuspendedInput{
+		ModelUUID:  modelUUID,
+		RelationID: *relationID,
+		Suspended:  d.Get("suspended").(bool),
+		Reason:     d.Get("suspended_reason").(string),
+	})
+}
+
+// waitForIntegrationStatus waits for the integration to be joined, or
+// suspended if it has been suspended, when wait_for_status is set.
+func waitForIntegrationStatus(d *schema.ResourceData, client *juju.Client, modelUUID string) error {
+	if !d.Get("wait_for_status").(bool) {
+		return nil
+	}
+
+	_, relationID, _, err := parseIntegrationID(d.Id())
+	if err != nil {
+		return err
+	}
+	if relationID == nil {
+		return fmt.Errorf("unable to find the relation id in integration ID %q", d.Id())
+	}
+
+	status := []string{"joined"}
+	if d.Get("suspended").(bool) {
+		status = []string{"suspended"}
+	}
+
+	return client.Integrations.WaitForIntegrationStatus(&juju.WaitForIntegrationStatusInput{
+		ModelUUID:  modelUUID,
+		RelationID: *relationID,
+		Status:     status,
+	})
+}
+
 func resourceIntegrationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	client := meta.(*juju.Client)
@@ -263,7 +439,7 @@ func resourceIntegrationDelete(ctx context.Context, d *schema.ResourceData, meta
 	modelName := d.Get("model").(string)
 	modelUUID, err := client.Models.ResolveModelUUID(modelName)
 	if err != nil {
-		return diag.FromErr(err)
+		return checkModelErr(err)
 	}
 
 	apps := d.Get("application").(*schema.Set).List()
@@ -299,27 +475,90 @@ func resourceIntegrationDelete(ctx context.Context, d *schema.ResourceData, meta
 	return diags
 }
 
-func generateID(modelName string, apps []juju.Application) string {
-	//In order to generate a stable iterable order we sort the endpoints keys by the role value (provider is always first to match `juju status` output)
-	//TODO: verify we always get only 2 endpoints and that the role value is consistent
-	keys := make([]int, len(apps))
-	for k, v := range apps {
-		if v.Role == "provider" {
-			keys[0] = k
-		} else if v.Role == "requirer" {
-			keys[1] = k
+// integrationIDVersion prefixes the current integration ID format:
+// "v2:<model>:<relation id>:<application>:<endpoint>:<application>:<endpoint>"
+const integrationIDVersion = "v2"
+
+func generateID(modelName string, relationID int, apps []juju.Application) string {
+	//In order to generate a stable iterable order we sort the endpoints by the role value (provider is always first to match `juju status` output)
+	sorted := append([]juju.Application{}, apps...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if (sorted[i].Role == "provider") != (sorted[j].Role == "provider") {
+			return sorted[i].Role == "provider"
 		}
-	}
+		return sorted[i].Name < sorted[j].Name
+	})
 
-	id := modelName
-	for _, key := range keys {
-		ep := apps[key]
+	id := fmt.Sprintf("%s:%s:%d", integrationIDVersion, modelName, relationID)
+	for _, ep := range sorted {
 		id = fmt.Sprintf("%s:%s:%s", id, ep.Name, ep.Endpoint)
 	}
 
 	return id
 }
 
+// parseIntegrationID parses the current and the previous integration ID
+// formats. The previous format, also accepted on import, is
+// "<model>:<application>:<endpoint>:<application>:<endpoint>" and has no
+// relation id. The endpoints can be in any order.
+func parseIntegrationID(id string) (modelName string, relationID *int, endpoints []string, err error) {
+	parts := strings.Split(id, ":")
+	switch {
+	case len(parts) == 7 && parts[0] == integrationIDVersion:
+		relation, err := strconv.Atoi(parts[2])
+		if err != nil {
+			return "", nil, nil, fmt.Errorf("invalid relation id %q in integration ID %q", parts[2], id)
+		}
+		relationID = &relation
+		parts = append([]string{parts[1]}, parts[3:]...)
+	case len(parts) == 5:
+	default:
+		return "", nil, nil, fmt.Errorf("unable to parse integration ID %q, expected %s:<model>:<relation id>:<application>:<endpoint>:<application>:<endpoint> or <model>:<application>:<endpoint>:<application>:<endpoint>", id, integrationIDVersion)
+	}
+
+	endpoints = []string{
+		fmt.Sprintf("%s:%s", parts[1], parts[2]),
+		fmt.Sprintf("%s:%s", parts[3], parts[4]),
+	}
+	return parts[0], relationID, endpoints, nil
+}
+
+// resourceIntegrationStateUpgradeV0 replaces the previous integration
+// ID with the current format, looking up the relation id in the model.
+func resourceIntegrationStateUpgradeV0(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	client := meta.(*juju.Client)
+
+	id, _ := rawState["id"].(string)
+	modelName, relationID, endpoints, err := parseIntegrationID(id)
+	if err != nil {
+		return nil, err
+	}
+	if relationID != nil {
+		return rawState, nil
+	}
+
+	modelUUID, err := client.Models.ResolveModelUUID(modelName)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := client.Integrations.ReadIntegration(&juju.IntegrationInput{
+		ModelUUID: modelUUID,
+		Endpoints: endpoints,
+	})
+	if err != nil {
+		// the integration is removed from the state by the next read
+		if IsIntegrationNotFound(err) {
+			return rawState, nil
+		}
+		return nil, err
+	}
+
+	rawState["id"] = generateID(modelName, response.ID, response.Applications)
+
+	return rawState, nil
+}
+
 // This function can be used to parse the terraform data into usable juju endpoints
 // it also does some sanity checks on inputs and returns user friendly errors
 func parseEndpoints(apps []interface{}) (endpoints []string, offer *string, appNames []string, err error) {
@@ -365,13 +604,29 @@ func parseEndpoints(apps []interface{}) (endpoints []string, offer *string, appN
 	return endpoints, offer, appNames, nil
 }
 
-func parseApplications(apps []juju.Application) []map[string]interface{} {
+// saasApplicationNames returns the names of the applications of the
+// integration referenced by name. These are kept as names when they
+// are SAAS applications, managed by a juju_saas resource.
+func saasApplicationNames(d *schema.ResourceData) map[string]bool {
+	names := make(map[string]bool)
+	for _, app := range d.Get("application").(*schema.Set).List() {
+		if app == nil {
+			continue
+		}
+		if name := app.(map[string]interface{})["name"].(string); name != "" {
+			names[name] = true
+		}
+	}
+	return names
+}
+
+func parseApplications(apps []juju.Application, saasNames map[string]bool) []map[string]interface{} {
 	applications := make([]map[string]interface{}, 0, 2)
 
 	for _, app := range apps {
 		a := make(map[string]interface{})
 
-		if app.OfferURL != nil {
+		if app.OfferURL != nil && !saasNames[app.Name] {
 			a["offer_url"] = app.OfferURL
 			a["endpoint"] = ""
 			a["name"] = ""
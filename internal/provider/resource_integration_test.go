@@ -2,11 +2,14 @@ package provider
 
 import (
 	"fmt"
+	"reflect"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/juju/terraform-provider-juju/internal/juju"
 )
 
 func TestAcc_ResourceIntegration(t *testing.T) {
@@ -21,21 +24,31 @@ func TestAcc_ResourceIntegration(t *testing.T) {
 				Config: testAccResourceIntegration(modelName, "two"),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("juju_integration.this", "model", modelName),
-					resource.TestCheckResourceAttr("juju_integration.this", "id", fmt.Sprintf("%v:%v:%v", modelName, "two:db-admin", "one:backend-db-admin")),
+					resource.TestMatchResourceAttr("juju_integration.this", "id", regexp.MustCompile(fmt.Sprintf("^v2:%v:[0-9]+:%v:%v$", modelName, "two:db-admin", "one:backend-db-admin"))),
 					resource.TestCheckResourceAttr("juju_integration.this", "application.#", "2"),
 					resource.TestCheckTypeSetElemNestedAttrs("juju_integration.this", "application.*", map[string]string{"name": "one", "endpoint": "backend-db-admin"}),
+					resource.TestCheckResourceAttr("juju_integration.this", "status", "joined"),
 				),
 			},
 			{
-				ImportStateVerify: true,
-				ImportState:       true,
-				ResourceName:      "juju_integration.this",
+				ImportStateVerify:       true,
+				ImportState:             true,
+				ImportStateVerifyIgnore: []string{"wait_for_status"},
+				ResourceName:            "juju_integration.this",
+			},
+			{
+				// endpoints can be given in any order on import
+				ImportStateVerify:       true,
+				ImportState:             true,
+				ImportStateVerifyIgnore: []string{"wait_for_status"},
+				ImportStateId:           fmt.Sprintf("%v:%v:%v", modelName, "one:backend-db-admin", "two:db-admin"),
+				ResourceName:            "juju_integration.this",
 			},
 			{
 				Config: testAccResourceIntegration(modelName, "two"),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("juju_integration.this", "model", modelName),
-					resource.TestCheckResourceAttr("juju_integration.this", "id", fmt.Sprintf("%v:%v:%v", modelName, "two:db-admin", "one:backend-db-admin")),
+					resource.TestMatchResourceAttr("juju_integration.this", "id", regexp.MustCompile(fmt.Sprintf("^v2:%v:[0-9]+:%v:%v$", modelName, "two:db-admin", "one:backend-db-admin"))),
 					resource.TestCheckResourceAttr("juju_integration.this", "application.#", "2"),
 				),
 			},
@@ -43,6 +56,66 @@ func TestAcc_ResourceIntegration(t *testing.T) {
 	})
 }
 
+func TestParseIntegrationID(t *testing.T) {
+	tests := []struct {
+		id         string
+		model      string
+		relationID int
+		endpoints  []string
+	}{{
+		id:         "v2:development:4:mysql:server:wordpress:db",
+		model:      "development",
+		relationID: 4,
+		endpoints:  []string{"mysql:server", "wordpress:db"},
+	}, {
+		id:         "v2:alice/development:0:mysql:server:wordpress:db",
+		model:      "alice/development",
+		relationID: 0,
+		endpoints:  []string{"mysql:server", "wordpress:db"},
+	}, {
+		id:         "development:wordpress:db:mysql:server",
+		model:      "development",
+		relationID: -1,
+		endpoints:  []string{"wordpress:db", "mysql:server"},
+	}}
+
+	for _, test := range tests {
+		model, relationID, endpoints, err := parseIntegrationID(test.id)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", test.id, err)
+			continue
+		}
+		if model != test.model {
+			t.Errorf("%s: expected model %q, got %q", test.id, test.model, model)
+		}
+		if test.relationID < 0 && relationID != nil {
+			t.Errorf("%s: expected no relation id, got %d", test.id, *relationID)
+		}
+		if test.relationID >= 0 && (relationID == nil || *relationID != test.relationID) {
+			t.Errorf("%s: expected relation id %d, got %v", test.id, test.relationID, relationID)
+		}
+		if !reflect.DeepEqual(endpoints, test.endpoints) {
+			t.Errorf("%s: expected endpoints %v, got %v", test.id, test.endpoints, endpoints)
+		}
+	}
+
+	for _, id := range []string{"development:wordpress:db", "v2:development:x:mysql:server:wordpress:db"} {
+		if _, _, _, err := parseIntegrationID(id); err == nil {
+			t.Errorf("%s: expected an error", id)
+		}
+	}
+}
+
+func TestGenerateIntegrationID(t *testing.T) {
+	id := generateID("development", 4, []juju.Application{
+		{Name: "wordpress", Endpoint: "db", Role: "requirer"},
+		{Name: "mysql", Endpoint: "server", Role: "provider"},
+	})
+	if id != "v2:development:4:mysql:server:wordpress:db" {
+		t.Errorf("unexpected integration ID %q", id)
+	}
+}
+
 func testAccCheckIntegrationDestroy(s *terraform.State) error {
 	return nil
 }
@@ -74,7 +147,8 @@ resource "juju_application" "two" {
 }
 
 resource "juju_integration" "this" {
-	model = juju_model.this.name
+	model           = juju_model.this.name
+	wait_for_status = true
 
 	application {
 		name     = juju_application.%s.name
@@ -105,7 +179,7 @@ func TestAcc_ResourceIntegrationWithViaCIDRs(t *testing.T) {
 				Config: testAccResourceIntegrationWithVia(srcModelName, dstModelName, via),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("juju_integration.a", "model", srcModelName),
-					resource.TestCheckResourceAttr("juju_integration.a", "id", fmt.Sprintf("%v:%v:%v", srcModelName, "a:db-admin", "b:backend-db-admin")),
+					resource.TestMatchResourceAttr("juju_integration.a", "id", regexp.MustCompile(fmt.Sprintf("^v2:%v:[0-9]+:%v:%v$", srcModelName, "a:db-admin", "b:backend-db-admin"))),
 					resource.TestCheckResourceAttr("juju_integration.a", "application.#", "2"),
 					resource.TestCheckTypeSetElemNestedAttrs("juju_integration.a", "application.*", map[string]string{"name": "a", "endpoint": "db-admin"}),
 					resource.TestCheckResourceAttr("juju_integration.a", "via", via),
@@ -0,0 +1,221 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/juju/errors"
+	"github.com/juju/terraform-provider-juju/internal/juju"
+)
+
+// The Kubernetes Cloud resource maps to a kubernetes cloud and its
+// credential on the controller, operated via `juju add-k8s --controller`,
+// `juju update-k8s --controller` and `juju remove-k8s --controller`.
+func resourceKubernetesCloud() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "A resource that represent a Juju Cloud of a Kubernetes cluster, with the credential used to access it, existing on the controller.",
+
+		CreateContext: resourceKubernetesCloudCreate,
+		ReadContext:   resourceKubernetesCloudRead,
+		UpdateContext: resourceKubernetesCloudUpdate,
+		DeleteContext: resourceKubernetesCloudDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Description: "The name of the cloud.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"host_cloud_region": {
+				Description: "The cloud and region hosting the cluster as `<cloud>/<region>`, e.g. `ec2/eu-west-1`. Defaults to `other`.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+			"kubernetes_config": {
+				Description:  "The content of a kubeconfig file describing the cluster and the credential, e.g. `file(\"~/.kube/config\")`.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				ExactlyOneOf: []string{"kubernetes_config", "endpoint"},
+			},
+			"context": {
+				Description:  "The context of the kubeconfig to use. Defaults to the current context of the kubeconfig.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"kubernetes_config"},
+			},
+			"endpoint": {
+				Description: "The endpoint of the Kubernetes API server, when no kubeconfig is given.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+			"ca_certificates": {
+				Description: "The PEM encoded CA certificates of the API server, when no kubeconfig is given.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"skip_tls_verify": {
+				Description: "Skip the verification of the certificate of the API server, when no kubeconfig is given.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+			},
+			"credential_name": {
+				Description: "The name of the credential of the cloud. Defaults to the name of the cloud.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+			},
+			"auth_type": {
+				Description:  "The auth type of the credential, e.g. `oauth2` or `clientcertificate`, when no kubeconfig is given.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				RequiredWith: []string{"endpoint"},
+			},
+			"attributes": {
+				Description:  "The attributes of the credential, e.g. `Token`, when no kubeconfig is given.",
+				Type:         schema.TypeMap,
+				Optional:     true,
+				Sensitive:    true,
+				RequiredWith: []string{"endpoint"},
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func resourceKubernetesCloudCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*juju.Client)
+
+	response, err := client.Clouds.AddKubernetesCloud(kubernetesCloudInput(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(response.Cloud.Name)
+	if err = d.Set("credential_name", response.CredentialName); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("auth_type", response.AuthType); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceKubernetesCloudRead(ctx, d, meta)
+}
+
+func resourceKubernetesCloudRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*juju.Client)
+
+	response, err := client.Clouds.ReadCloud(juju.ReadCloudInput{
+		Name: d.Id(),
+	})
+	if errors.Is(err, errors.NotFound) {
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloud := response.Cloud
+	if cloud.Type != "kubernetes" {
+		return diag.Errorf("cloud %s is a %s cloud, not a kubernetes one", cloud.Name, cloud.Type)
+	}
+	if err = d.Set("name", cloud.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("host_cloud_region", cloud.HostCloudRegion); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("endpoint", cloud.Endpoint); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("ca_certificates", cloud.CACertificates); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("skip_tls_verify", cloud.SkipTLSVerify); err != nil {
+		return diag.FromErr(err)
+	}
+	// The credential is not read back as the controller does not return
+	// its secrets, only its name is known when importing.
+	if d.Get("credential_name").(string) == "" {
+		if err = d.Set("credential_name", cloud.Name); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+func resourceKubernetesCloudUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*juju.Client)
+
+	response, err := client.Clouds.UpdateKubernetesCloud(kubernetesCloudInput(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("auth_type", response.AuthType); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceKubernetesCloudRead(ctx, d, meta)
+}
+
+func resourceKubernetesCloudDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*juju.Client)
+
+	err := client.Clouds.DestroyKubernetesCloud(juju.DestroyKubernetesCloudInput{
+		Name:           d.Id(),
+		CredentialName: d.Get("credential_name").(string),
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+// kubernetesCloudInput returns the input describing the cloud. The
+// computed cluster fields are only used when no kubeconfig is given.
+func kubernetesCloudInput(d *schema.ResourceData) juju.KubernetesCloudInput {
+	input := juju.KubernetesCloudInput{
+		Name:            d.Get("name").(string),
+		HostCloudRegion: d.Get("host_cloud_region").(string),
+		KubeConfig:      d.Get("kubernetes_config").(string),
+		Context:         d.Get("context").(string),
+		CredentialName:  d.Get("credential_name").(string),
+	}
+	if input.KubeConfig != "" {
+		return input
+	}
+
+	input.Endpoint = d.Get("endpoint").(string)
+	input.SkipTLSVerify = d.Get("skip_tls_verify").(bool)
+	input.AuthType = d.Get("auth_type").(string)
+	for _, cert := range d.Get("ca_certificates").([]interface{}) {
+		input.CACertificates = append(input.CACertificates, cert.(string))
+	}
+	input.Attributes = make(map[string]string)
+	for name, value := range d.Get("attributes").(map[string]interface{}) {
+		input.Attributes[name] = value.(string)
+	}
+	return input
+}
@@ -0,0 +1,106 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const testAccKubeConfig = `apiVersion: v1
+kind: Config
+current-context: microk8s
+clusters:
+- name: microk8s-cluster
+  cluster:
+    server: https://10.0.0.10:16443
+    insecure-skip-tls-verify: true
+contexts:
+- name: microk8s
+  context:
+    cluster: microk8s-cluster
+    user: admin
+users:
+- name: admin
+  user:
+    token: fake-token
+`
+
+func TestAcc_ResourceKubernetesCloud_KubeConfig(t *testing.T) {
+	cloudName := acctest.RandomWithPrefix("tf-test-k8s")
+
+	resourceName := "juju_kubernetes_cloud.this"
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceKubernetesCloudKubeConfig(cloudName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", cloudName),
+					resource.TestCheckResourceAttr(resourceName, "endpoint", "https://10.0.0.10:16443"),
+					resource.TestCheckResourceAttr(resourceName, "skip_tls_verify", "true"),
+					resource.TestCheckResourceAttr(resourceName, "host_cloud_region", "other"),
+					resource.TestCheckResourceAttr(resourceName, "credential_name", cloudName),
+					resource.TestCheckResourceAttr(resourceName, "auth_type", "oauth2"),
+				),
+			},
+			{
+				ImportStateVerify:       true,
+				ImportState:             true,
+				ImportStateVerifyIgnore: []string{"kubernetes_config", "auth_type"},
+				ResourceName:            resourceName,
+			},
+		},
+	})
+}
+
+func TestAcc_ResourceKubernetesCloud_Attributes(t *testing.T) {
+	cloudName := acctest.RandomWithPrefix("tf-test-k8s")
+
+	resourceName := "juju_kubernetes_cloud.this"
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceKubernetesCloudAttributes(cloudName, "https://10.0.0.20:6443"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "endpoint", "https://10.0.0.20:6443"),
+					resource.TestCheckResourceAttr(resourceName, "credential_name", "k8s-admin"),
+				),
+			},
+			{
+				Config: testAccResourceKubernetesCloudAttributes(cloudName, "https://10.0.0.21:6443"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "endpoint", "https://10.0.0.21:6443"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceKubernetesCloudKubeConfig(cloudName string) string {
+	return fmt.Sprintf(`
+resource "juju_kubernetes_cloud" "this" {
+  name              = %q
+  kubernetes_config = <<-EOT
+%sEOT
+}`, cloudName, testAccKubeConfig)
+}
+
+func testAccResourceKubernetesCloudAttributes(cloudName, endpoint string) string {
+	return fmt.Sprintf(`
+resource "juju_kubernetes_cloud" "this" {
+  name            = %q
+  endpoint        = %q
+  skip_tls_verify = true
+  credential_name = "k8s-admin"
+  auth_type       = "oauth2"
+
+  attributes = {
+    Token = "fake-token"
+  }
+}`, cloudName, endpoint)
+}
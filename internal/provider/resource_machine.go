@@ -7,6 +7,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/juju/names/v4"
 
 	"github.com/juju/terraform-provider-juju/internal/juju"
 )
@@ -17,6 +18,7 @@ func resourceMachine() *schema.Resource {
 
 		CreateContext: resourceMachineCreate,
 		ReadContext:   resourceMachineRead,
+		UpdateContext: resourceMachineUpdate,
 		DeleteContext: resourceMachineDelete,
 
 		Importer: &schema.ResourceImporter{
@@ -32,7 +34,7 @@ func resourceMachine() *schema.Resource {
 				ForceNew:    true,
 			},
 			"model": {
-				Description: "The Juju model in which to add a new machine.",
+				Description: "The Juju model in which to add a new machine, qualified with its owner as `owner/name` for models owned by other users.",
 				Type:        schema.TypeString,
 				Required:    true,
 				ForceNew:    true,
@@ -57,6 +59,7 @@ func resourceMachine() *schema.Resource {
 				Required:    true,
 				ForceNew:    true,
 			},
+			"annotations": annotationsSchema("machine"),
 			"machine_id": {
 				Description: "The id of the machine Juju creates.",
 				Type:        schema.TypeString,
@@ -74,7 +77,7 @@ func resourceMachineCreate(ctx context.Context, d *schema.ResourceData, meta int
 	modelName := d.Get("model").(string)
 	modelUUID, err := client.Models.ResolveModelUUID(modelName)
 	if err != nil {
-		return diag.FromErr(err)
+		return checkModelErr(err)
 	}
 	name := d.Get("name").(string)
 	constraints := d.Get("constraints").(string)
@@ -99,6 +102,11 @@ func resourceMachineCreate(ctx context.Context, d *schema.ResourceData, meta int
 		return diag.FromErr(err)
 	}
 	d.SetId(id)
+
+	if err = updateAnnotations(&client.Annotations, d, modelUUID, names.NewMachineTag(response.Machines[0].Machine)); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return nil
 }
 
@@ -115,7 +123,7 @@ func resourceMachineRead(ctx context.Context, d *schema.ResourceData, meta inter
 	modelName, machineId, machineName := id[0], id[1], id[2]
 	modelUUID, err := client.Models.ResolveModelUUID(modelName)
 	if err != nil {
-		return diag.FromErr(err)
+		return checkModelErr(err)
 	}
 
 	response, err := client.Machines.ReadMachine(&juju.ReadMachineInput{
@@ -143,9 +151,36 @@ func resourceMachineRead(ctx context.Context, d *schema.ResourceData, meta inter
 		return diag.FromErr(err)
 	}
 
+	if err = readAnnotations(&client.Annotations, d, modelUUID, names.NewMachineTag(machineId)); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return diags
 }
 
+// resourceMachineUpdate only updates the annotations, any other change
+// replaces the machine.
+func resourceMachineUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*juju.Client)
+
+	id := strings.Split(d.Id(), ":")
+	if len(id) != 3 {
+		return diag.Errorf("unable to parse model, machine ID, and name from provided ID")
+	}
+
+	modelName, machineId := id[0], id[1]
+	modelUUID, err := client.Models.ResolveModelUUID(modelName)
+	if err != nil {
+		return checkModelErr(err)
+	}
+
+	if err = updateAnnotations(&client.Annotations, d, modelUUID, names.NewMachineTag(machineId)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceMachineRead(ctx, d, meta)
+}
+
 func resourceMachineDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 
@@ -156,7 +191,7 @@ func resourceMachineDelete(ctx context.Context, d *schema.ResourceData, meta int
 	modelName, machineId, _ := id[0], id[1], id[2]
 	modelUUID, err := client.Models.ResolveModelUUID(modelName)
 	if err != nil {
-		return diag.FromErr(err)
+		return checkModelErr(err)
 	}
 
 	err = client.Machines.DestroyMachine(&juju.DestroyMachineInput{
@@ -59,6 +59,7 @@ func resourceModel() *schema.Resource {
 				Type:        schema.TypeMap,
 				Optional:    true,
 			},
+			"annotations": annotationsSchema("model"),
 			"constraints": {
 				Description: "Constraints imposed to this model",
 				Type:        schema.TypeString,
@@ -70,6 +71,37 @@ func resourceModel() *schema.Resource {
 				Optional:    true,
 				Computed:    true,
 			},
+			"controller": {
+				Description: "The controller hosting the model. Defaults to the controller configured in the provider. Changing it to another controller migrates the model to it, changing only the settings used to reach the same controller updates them.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"addresses": {
+							Description: "The controller addresses to connect to, in the format <host>:<port>,<host>:<port>,...",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+						"username": {
+							Description: "The username registered with the controller",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+						"password": {
+							Description: "The password of the username",
+							Type:        schema.TypeString,
+							Required:    true,
+							Sensitive:   true,
+						},
+						"ca_certificate": {
+							Description: "The certificate of the controller",
+							Type:        schema.TypeString,
+							Optional:    true,
+						},
+					},
+				},
+			},
 			"type": {
 				Description: "Type of the model. Set by the Juju's API server",
 				Type:        schema.TypeString,
@@ -79,8 +111,26 @@ func resourceModel() *schema.Resource {
 	}
 }
 
+// modelControllerConfig returns the configuration of the controller set
+// in the `controller` block, or nil if the model lives in the controller
+// configured in the provider.
+func modelControllerConfig(controller interface{}) *juju.Configuration {
+	controllerList := controller.([]interface{})
+	if len(controllerList) == 0 || controllerList[0] == nil {
+		return nil
+	}
+	controllerMap := controllerList[0].(map[string]interface{})
+	return &juju.Configuration{
+		ControllerAddresses: strings.Split(controllerMap["addresses"].(string), ","),
+		Username:            controllerMap["username"].(string),
+		Password:            controllerMap["password"].(string),
+		CACert:              controllerMap["ca_certificate"].(string),
+	}
+}
+
 func resourceModelCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*juju.Client)
+	models := client.Models.ForController(modelControllerConfig(d.Get("controller")))
 
 	var diags diag.Diagnostics
 
@@ -99,7 +149,7 @@ func resourceModelCreate(ctx context.Context, d *schema.ResourceData, meta inter
 		}
 	}
 
-	response, err := client.Models.CreateModel(juju.CreateModelInput{
+	response, err := models.CreateModel(juju.CreateModelInput{
 		Name:        name,
 		CloudList:   cloud,
 		Config:      config,
@@ -115,16 +165,22 @@ func resourceModelCreate(ctx context.Context, d *schema.ResourceData, meta inter
 
 	d.SetId(response.ModelInfo.UUID)
 
+	annotations := client.Annotations.ForController(modelControllerConfig(d.Get("controller")))
+	if err := updateAnnotations(annotations, d, response.ModelInfo.UUID, names.NewModelTag(response.ModelInfo.UUID)); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return diags
 }
 
 func resourceModelRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*juju.Client)
+	models := client.Models.ForController(modelControllerConfig(d.Get("controller")))
 
 	var diags diag.Diagnostics
 
 	uuid := d.Id()
-	response, err := client.Models.ReadModel(uuid)
+	response, err := models.ReadModel(uuid)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -179,15 +235,43 @@ func resourceModelRead(ctx context.Context, d *schema.ResourceData, meta interfa
 		return diag.FromErr(err)
 	}
 
+	annotations := client.Annotations.ForController(modelControllerConfig(d.Get("controller")))
+	if err = readAnnotations(annotations, d, uuid, names.NewModelTag(uuid)); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return diags
 }
 
 func resourceModelUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*juju.Client)
+	models := client.Models.ForController(modelControllerConfig(d.Get("controller")))
 
 	var diags diag.Diagnostics
 	anyChange := false
 
+	// a change of controller migrates the model, unless only the
+	// settings used to reach the same controller changed. Any other
+	// change is applied once the model is available in the new controller
+	if d.HasChange("controller") {
+		oldController, newController := d.GetChange("controller")
+		source := client.Models.ForController(modelControllerConfig(oldController))
+		target := client.Models.ForController(modelControllerConfig(newController))
+		if err := source.MigrateModel(juju.MigrateModelInput{
+			UUID:   d.Id(),
+			Target: target,
+		}); err != nil {
+			// keep the previous controller in the state
+			d.Partial(true)
+			return diag.FromErr(err)
+		}
+	}
+
+	annotations := client.Annotations.ForController(modelControllerConfig(d.Get("controller")))
+	if err := updateAnnotations(annotations, d, d.Id(), names.NewModelTag(d.Id())); err != nil {
+		return diag.FromErr(err)
+	}
+
 	// items that could be changed
 	var newConfigMap map[string]interface{}
 	var newConstraints *constraints.Value = nil
@@ -198,14 +282,8 @@ func resourceModelUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 	if d.HasChange("config") {
 		anyChange = true
 		oldConfig, newConfig := d.GetChange("config")
-		oldConfigMap := oldConfig.(map[string]interface{})
 		newConfigMap = newConfig.(map[string]interface{})
-
-		for k := range oldConfigMap {
-			if _, ok := newConfigMap[k]; !ok {
-				unsetConfigKeys = append(unsetConfigKeys, k)
-			}
-		}
+		unsetConfigKeys = getUnsetConfigKeys(oldConfig.(map[string]interface{}), newConfigMap)
 	}
 
 	if d.HasChange("constraints") {
@@ -230,7 +308,7 @@ func resourceModelUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 
 	cloud := d.Get("cloud").([]interface{})
 
-	err = client.Models.UpdateModel(juju.UpdateModelInput{
+	err = models.UpdateModel(juju.UpdateModelInput{
 		UUID:        d.Id(),
 		CloudList:   cloud,
 		Config:      newConfigMap,
@@ -245,16 +323,29 @@ func resourceModelUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 	return diags
 }
 
+// getUnsetConfigKeys returns the configuration keys present in oldConfig
+// that were removed from newConfig and have to be unset.
+func getUnsetConfigKeys(oldConfig, newConfig map[string]interface{}) []string {
+	var unset []string
+	for k := range oldConfig {
+		if _, ok := newConfig[k]; !ok {
+			unset = append(unset, k)
+		}
+	}
+	return unset
+}
+
 // Juju refers to model deletion as "destroy" so we call the Destroy function of our client here rather than delete
 // This function remains named Delete for parity across the provider and to stick within terraform naming conventions
 func resourceModelDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*juju.Client)
+	models := client.Models.ForController(modelControllerConfig(d.Get("controller")))
 
 	var diags diag.Diagnostics
 
 	modelUUID := d.Id()
 
-	err := client.Models.DestroyModel(juju.DestroyModelInput{
+	err := models.DestroyModel(juju.DestroyModelInput{
 		UUID: modelUUID,
 	})
 	if err != nil {
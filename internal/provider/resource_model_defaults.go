@@ -0,0 +1,204 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/juju/terraform-provider-juju/internal/juju"
+)
+
+func resourceModelDefaults() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "A resource that represents the default configuration of the models created in a cloud or cloud region.",
+
+		CreateContext: resourceModelDefaultsCreate,
+		ReadContext:   resourceModelDefaultsRead,
+		UpdateContext: resourceModelDefaultsUpdate,
+		DeleteContext: resourceModelDefaultsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceModelDefaultsImporter,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cloud": {
+				Description: "The name of the cloud the defaults apply to",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"region": {
+				Description: "The region of the cloud the defaults apply to. If empty, the defaults apply to the whole cloud",
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"config": {
+				Description: "Default model configuration",
+				Type:        schema.TypeMap,
+				Required:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceModelDefaultsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*juju.Client)
+
+	var diags diag.Diagnostics
+
+	cloud := d.Get("cloud").(string)
+	region := d.Get("region").(string)
+	config := d.Get("config").(map[string]interface{})
+
+	err := client.Models.UpdateModelDefaults(juju.UpdateModelDefaultsInput{
+		Cloud:  cloud,
+		Region: region,
+		Config: config,
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", cloud, region))
+
+	return diags
+}
+
+func resourceModelDefaultsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*juju.Client)
+
+	var diags diag.Diagnostics
+
+	id := strings.Split(d.Id(), ":")
+	if len(id) != 2 {
+		return diag.Errorf("unable to parse cloud and region from provided ID")
+	}
+	cloud, region := id[0], id[1]
+
+	response, err := client.Models.ReadModelDefaults(juju.ReadModelDefaultsInput{
+		Cloud:  cloud,
+		Region: region,
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("cloud", cloud); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("region", region); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Only read the defaults tracked in Terraform, a default unset
+	// outside of Terraform is removed so it is set again
+	config := d.Get("config").(map[string]interface{})
+	for k := range config {
+		if value, exists := response.Config[k]; exists {
+			config[k] = juju.ConfigEntryToString(value)
+		} else {
+			delete(config, k)
+		}
+	}
+	if err = d.Set("config", config); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceModelDefaultsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*juju.Client)
+
+	var diags diag.Diagnostics
+
+	if !d.HasChange("config") {
+		return diags
+	}
+
+	oldConfig, newConfig := d.GetChange("config")
+	newConfigMap := newConfig.(map[string]interface{})
+	unsetConfigKeys := getUnsetConfigKeys(oldConfig.(map[string]interface{}), newConfigMap)
+
+	err := client.Models.UpdateModelDefaults(juju.UpdateModelDefaultsInput{
+		Cloud:  d.Get("cloud").(string),
+		Region: d.Get("region").(string),
+		Config: newConfigMap,
+		Unset:  unsetConfigKeys,
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+// resourceModelDefaultsDelete unsets all the defaults tracked by the resource.
+func resourceModelDefaultsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*juju.Client)
+
+	var diags diag.Diagnostics
+
+	config := d.Get("config").(map[string]interface{})
+	unsetConfigKeys := getUnsetConfigKeys(config, nil)
+
+	if len(unsetConfigKeys) != 0 {
+		err := client.Models.UpdateModelDefaults(juju.UpdateModelDefaultsInput{
+			Cloud:  d.Get("cloud").(string),
+			Region: d.Get("region").(string),
+			Unset:  unsetConfigKeys,
+		})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+// resourceModelDefaultsImporter adopts all the defaults set in the cloud
+// or cloud region, as Read only refreshes the defaults already tracked.
+func resourceModelDefaultsImporter(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client := meta.(*juju.Client)
+
+	// the region is optional: `cloud` and `cloud:region` are both valid IDs
+	id := strings.SplitN(d.Id(), ":", 2)
+	cloud := id[0]
+	region := ""
+	if len(id) == 2 {
+		region = id[1]
+	}
+
+	if err := d.Set("cloud", cloud); err != nil {
+		return nil, err
+	}
+	if err := d.Set("region", region); err != nil {
+		return nil, err
+	}
+
+	response, err := client.Models.ReadModelDefaults(juju.ReadModelDefaultsInput{
+		Cloud:  cloud,
+		Region: region,
+	})
+	if err != nil {
+		return nil, err
+	}
+	config := make(map[string]interface{}, len(response.Config))
+	for k, value := range response.Config {
+		config[k] = juju.ConfigEntryToString(value)
+	}
+	if err := d.Set("config", config); err != nil {
+		return nil, err
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", cloud, region))
+
+	return []*schema.ResourceData{d}, nil
+}
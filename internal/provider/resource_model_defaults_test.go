@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAcc_ResourceModelDefaults_Basic(t *testing.T) {
+	resourceName := "juju_model_defaults.this"
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceModelDefaults(t, "localhost", "<root>=INFO"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "cloud", "localhost"),
+					resource.TestCheckResourceAttr(resourceName, "config.logging-config", "<root>=INFO"),
+				),
+			},
+			{
+				Config: testAccResourceModelDefaults(t, "localhost", "<root>=DEBUG"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "config.logging-config", "<root>=DEBUG"),
+				),
+			},
+			{
+				ImportStateVerify: true,
+				ImportState:       true,
+				ImportStateId:     "localhost",
+				ResourceName:      resourceName,
+			},
+		},
+	})
+}
+
+func testAccResourceModelDefaults(t *testing.T, cloud string, logLevel string) string {
+	return fmt.Sprintf(`
+resource "juju_model_defaults" "this" {
+  cloud = %q
+
+  config = {
+    logging-config = %q
+  }
+}`, cloud, logLevel)
+}
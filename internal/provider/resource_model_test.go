@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/juju/juju/api/client/modelconfig"
 	"github.com/juju/juju/rpc/params"
+	"github.com/juju/names/v4"
 	"github.com/juju/terraform-provider-juju/internal/juju"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -101,6 +102,72 @@ resource "juju_model" "this" {
 	})
 }
 
+func TestAcc_ResourceModel_Annotations(t *testing.T) {
+	modelName := acctest.RandomWithPrefix("tf-test-model")
+
+	resourceName := "juju_model.this"
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "juju_model" "this" {
+  name = %q
+
+  annotations = {
+    owner       = "platform"
+    cost-center = "1234"
+  }
+}`, modelName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "annotations.owner", "platform"),
+					resource.TestCheckResourceAttr(resourceName, "annotations.cost-center", "1234"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+resource "juju_model" "this" {
+  name = %q
+
+  annotations = {
+    owner = "observability"
+  }
+}`, modelName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "annotations.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "annotations.owner", "observability"),
+					testAccCheckModelAnnotationIsUnset(modelName, "cost-center"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckModelAnnotationIsUnset(modelName string, key string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := Provider.Meta().(*juju.Client)
+
+		uuid, err := client.Models.ResolveModelUUID(modelName)
+		if err != nil {
+			return err
+		}
+
+		response, err := client.Annotations.GetAnnotations(juju.GetAnnotationsInput{
+			ModelUUID: uuid,
+			EntityTag: names.NewModelTag(uuid),
+		})
+		if err != nil {
+			return err
+		}
+
+		if value, exists := response.Annotations[key]; exists {
+			return fmt.Errorf("expecting annotation %q of model %s to be unset but was: %q", key, modelName, value)
+		}
+		return nil
+	}
+}
+
 func testAccCheckDevelopmentConfigIsUnset(modelName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		client := Provider.Meta().(*juju.Client)
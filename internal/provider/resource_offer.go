@@ -2,12 +2,23 @@ package provider
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	jujuerrors "github.com/juju/errors"
+	"github.com/juju/juju/core/crossmodel"
 	"github.com/juju/terraform-provider-juju/internal/juju"
 )
 
+const (
+	offerDefaultDestroyTimeout = "5m"
+	offerDefaultForceOnTimeout = true
+)
+
 func resourceOffer() *schema.Resource {
 	return &schema.Resource{
 		// This description is used by the documentation generator and the language server.
@@ -15,13 +26,14 @@ func resourceOffer() *schema.Resource {
 
 		CreateContext: resourceOfferCreate,
 		ReadContext:   resourceOfferRead,
+		UpdateContext: resourceOfferUpdate,
 		DeleteContext: resourceOfferDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceOfferImporter,
 		},
 		Schema: map[string]*schema.Schema{
 			"model": {
-				Description: "The name of the model to operate in.",
+				Description: "The name of the model to operate in, qualified with its owner as `owner/name` for models owned by other users.",
 				Type:        schema.TypeString,
 				Required:    true,
 				ForceNew:    true,
@@ -40,11 +52,49 @@ func resourceOffer() *schema.Resource {
 				ForceNew:    true,
 			},
 			"endpoint": {
-				Description: "The endpoint name.",
+				Description:  "The endpoint name.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Deprecated:   "Use `endpoints` instead.",
+				ExactlyOneOf: []string{"endpoint", "endpoints"},
+			},
+			"endpoints": {
+				Description:  "The endpoint names. Changing them updates the offer in place.",
+				Type:         schema.TypeSet,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"endpoint", "endpoints"},
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"description": {
+				Description: "The description of the offer. Defaults to the description of the charm.",
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
+				Computed:    true,
+			},
+			"owner": {
+				Description: "The user owning the offer. Defaults to the user authenticated with the controller.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
 				ForceNew:    true,
 			},
+			"destroy_timeout": {
+				Description:  "How long to wait for the consumers to remove their integrations with the offer when destroying it, as a duration such as `10m`.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      offerDefaultDestroyTimeout,
+				ValidateFunc: validateDuration,
+			},
+			"force_on_timeout": {
+				Description: "Whether to force the removal of the offer, severing the integrations of its consumers, when they are not removed within `destroy_timeout`. Otherwise destroying the offer fails, listing the remaining integrations.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     offerDefaultForceOnTimeout,
+			},
 			"url": {
 				Description: "The offer URL.",
 				Type:        schema.TypeString,
@@ -61,7 +111,7 @@ func resourceOfferCreate(ctx context.Context, d *schema.ResourceData, meta inter
 	modelName := d.Get("model").(string)
 	modelUUID, err := client.Models.ResolveModelUUID(modelName)
 	if err != nil {
-		return diag.FromErr(err)
+		return checkModelErr(err)
 	}
 
 	//here we verify if the name property is set, if not set to the application name
@@ -78,7 +128,9 @@ func resourceOfferCreate(ctx context.Context, d *schema.ResourceData, meta inter
 		ModelUUID:       modelUUID,
 		Name:            offerName,
 		ApplicationName: d.Get("application_name").(string),
-		Endpoint:        d.Get("endpoint").(string),
+		Endpoints:       offerEndpoints(d),
+		Description:     d.Get("description").(string),
+		Owner:           d.Get("owner").(string),
 	})
 	if errs != nil {
 		if len(errs) == 1 {
@@ -98,11 +150,30 @@ func resourceOfferCreate(ctx context.Context, d *schema.ResourceData, meta inter
 	if err = d.Set("url", result.OfferURL); err != nil {
 		return diag.FromErr(err)
 	}
+	if err = d.Set("owner", result.Owner); err != nil {
+		return diag.FromErr(err)
+	}
 
 	//TODO: check that a URL is unique
 	d.SetId(result.OfferURL)
 
-	return diags
+	return resourceOfferRead(ctx, d, meta)
+}
+
+// offerEndpoints returns the endpoints of the offer, set either with
+// `endpoints` or the deprecated `endpoint`.
+func offerEndpoints(d *schema.ResourceData) []string {
+	if endpoint, ok := d.GetOk("endpoint"); ok && !d.HasChange("endpoints") {
+		if _, set := d.GetOk("endpoints"); !set || d.HasChange("endpoint") {
+			return []string{endpoint.(string)}
+		}
+	}
+
+	endpoints := []string{}
+	for _, endpoint := range d.Get("endpoints").(*schema.Set).List() {
+		endpoints = append(endpoints, endpoint.(string))
+	}
+	return endpoints
 }
 
 func resourceOfferRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -113,11 +184,20 @@ func resourceOfferRead(ctx context.Context, d *schema.ResourceData, meta interfa
 	result, err := client.Offers.ReadOffer(&juju.ReadOfferInput{
 		OfferURL: d.Id(),
 	})
+	if jujuerrors.Is(err, jujuerrors.NotFound) {
+		d.SetId("")
+		return nil
+	}
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	if err = d.Set("model", result.ModelName); err != nil {
+	// keep the owner qualification of the model if it was used
+	modelName := result.ModelName
+	if strings.Contains(d.Get("model").(string), "/") {
+		modelName = fmt.Sprintf("%s/%s", result.ModelOwner, result.ModelName)
+	}
+	if err = d.Set("model", modelName); err != nil {
 		return diag.FromErr(err)
 	}
 	if err = d.Set("name", result.Name); err != nil {
@@ -126,9 +206,27 @@ func resourceOfferRead(ctx context.Context, d *schema.ResourceData, meta interfa
 	if err = d.Set("application_name", result.ApplicationName); err != nil {
 		return diag.FromErr(err)
 	}
-	if err = d.Set("endpoint", result.Endpoint); err != nil {
+	// the deprecated endpoint is only kept for offers of a single endpoint
+	endpoint := ""
+	if len(result.Endpoints) == 1 {
+		endpoint = result.Endpoints[0]
+	}
+	if err = d.Set("endpoint", endpoint); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("endpoints", result.Endpoints); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("description", result.Description); err != nil {
 		return diag.FromErr(err)
 	}
+	// the owner is not reported by Juju, imported offers are owned by
+	// the owner of the model
+	if d.Get("owner").(string) == "" {
+		if err = d.Set("owner", result.ModelOwner); err != nil {
+			return diag.FromErr(err)
+		}
+	}
 	if err = d.Set("url", result.OfferURL); err != nil {
 		return diag.FromErr(err)
 	}
@@ -137,19 +235,92 @@ func resourceOfferRead(ctx context.Context, d *schema.ResourceData, meta interfa
 	return diags
 }
 
+func resourceOfferUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*juju.Client)
+
+	if !d.HasChanges("endpoint", "endpoints", "description") {
+		return resourceOfferRead(ctx, d, meta)
+	}
+
+	modelUUID, err := client.Models.ResolveModelUUID(d.Get("model").(string))
+	if err != nil {
+		return checkModelErr(err)
+	}
+
+	err = client.Offers.UpdateOffer(&juju.UpdateOfferInput{
+		ApplicationName: d.Get("application_name").(string),
+		Endpoints:       offerEndpoints(d),
+		Description:     d.Get("description").(string),
+		ModelUUID:       modelUUID,
+		Name:            d.Get("name").(string),
+		Owner:           d.Get("owner").(string),
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceOfferRead(ctx, d, meta)
+}
+
 func resourceOfferDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*juju.Client)
 
 	var diags diag.Diagnostics
 
-	err := client.Offers.DestroyOffer(&juju.DestroyOfferInput{
-		OfferURL: d.Get("url").(string),
+	timeout, err := time.ParseDuration(d.Get("destroy_timeout").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	response, err := client.Offers.DestroyOffer(ctx, &juju.DestroyOfferInput{
+		OfferURL:       d.Get("url").(string),
+		Timeout:        timeout,
+		ForceOnTimeout: d.Get("force_on_timeout").(bool),
 	})
+	var connectionsErr *juju.OfferConnectionsError
+	if errors.As(err, &connectionsErr) {
+		return append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Offer %s is still in use", connectionsErr.OfferURL),
+			Detail:   fmt.Sprintf("The following integrations were not removed within %s, remove them or set force_on_timeout to sever them:\n%s", timeout, offerConnectionsDetail(connectionsErr.Connections)),
+		})
+	}
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	if len(response.ForcedConnections) > 0 {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("Offer %s was forcibly destroyed", d.Get("url").(string)),
+			Detail:   fmt.Sprintf("The following integrations were not removed within %s and have been severed:\n%s", timeout, offerConnectionsDetail(response.ForcedConnections)),
+		})
+	}
 
 	d.SetId("")
 
 	return diags
 }
+
+// offerConnectionsDetail describes the consumers connected to an offer,
+// one per line.
+func offerConnectionsDetail(connections []crossmodel.OfferConnection) string {
+	lines := make([]string, 0, len(connections))
+	for _, connection := range connections {
+		lines = append(lines, fmt.Sprintf("  - relation %d on endpoint %q, consumed by %s from model %s (%s)",
+			connection.RelationId, connection.Endpoint, connection.Username, connection.SourceModelUUID, connection.Status))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// resourceOfferImporter sets the defaults of the destroy options, which
+// are not stored in Juju.
+func resourceOfferImporter(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if err := d.Set("destroy_timeout", offerDefaultDestroyTimeout); err != nil {
+		return nil, err
+	}
+	if err := d.Set("force_on_timeout", offerDefaultForceOnTimeout); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -24,6 +24,11 @@ func TestAcc_ResourceOffer_Basic(t *testing.T) {
 					resource.TestCheckResourceAttr("juju_offer.this", "id", fmt.Sprintf("%v/%v.%v", "admin", modelName, "this")),
 				),
 			},
+			{
+				// Juju uses the charm description when no description is set
+				Config:   testAccResourceOffer(modelName),
+				PlanOnly: true,
+			},
 			{
 				Config: testAccResourceOfferXIntegration(modelName, destModelName),
 				Check: resource.ComposeTestCheckFunc(
@@ -41,6 +46,67 @@ func TestAcc_ResourceOffer_Basic(t *testing.T) {
 	})
 }
 
+func TestAcc_ResourceOffer_MultipleEndpoints(t *testing.T) {
+	modelName := acctest.RandomWithPrefix("tf-test-offer")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceOfferEndpoints(modelName, `"db"`, "database"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("juju_offer.this", "owner", "admin"),
+					resource.TestCheckResourceAttr("juju_offer.this", "description", "database"),
+					resource.TestCheckResourceAttr("juju_offer.this", "endpoints.#", "1"),
+					resource.TestCheckResourceAttr("juju_offer.this", "endpoint", "db"),
+				),
+			},
+			{
+				Config: testAccResourceOfferEndpoints(modelName, `"db", "db-admin"`, "database and admin access"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("juju_offer.this", "description", "database and admin access"),
+					resource.TestCheckResourceAttr("juju_offer.this", "endpoints.#", "2"),
+					resource.TestCheckTypeSetElemAttr("juju_offer.this", "endpoints.*", "db"),
+					resource.TestCheckTypeSetElemAttr("juju_offer.this", "endpoints.*", "db-admin"),
+					resource.TestCheckResourceAttr("juju_offer.this", "endpoint", ""),
+					resource.TestCheckResourceAttr("juju_offer.this", "url", fmt.Sprintf("%v/%v.%v", "admin", modelName, "this")),
+				),
+			},
+			{
+				ImportStateVerify: true,
+				ImportState:       true,
+				ResourceName:      "juju_offer.this",
+			},
+		},
+	})
+}
+
+func testAccResourceOfferEndpoints(modelName string, endpoints string, description string) string {
+	return fmt.Sprintf(`
+resource "juju_model" "this" {
+	name = %q
+}
+
+resource "juju_application" "this" {
+	model = juju_model.this.name
+	name  = "this"
+
+	charm {
+		name = "postgresql"
+		series = "focal"
+	}
+}
+
+resource "juju_offer" "this" {
+	model            = juju_model.this.name
+	application_name = juju_application.this.name
+	endpoints        = [%s]
+	description      = %q
+}
+`, modelName, endpoints, description)
+}
+
 func testAccResourceOffer(modelName string) string {
 	return fmt.Sprintf(`
 resource "juju_model" "this" {
@@ -0,0 +1,177 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/juju/terraform-provider-juju/internal/juju"
+)
+
+func resourceSAAS() *schema.Resource {
+	return &schema.Resource{
+		Description: "A resource that represents a Juju SAAS application, consuming an offer in a model. Integrations reference it by name like any other application.",
+
+		CreateContext: resourceSAASCreate,
+		ReadContext:   resourceSAASRead,
+		DeleteContext: resourceSAASDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"model": {
+				Description: "The name of the model consuming the offer, qualified with its owner as `owner/name` for models owned by other users.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"offer_url": {
+				Description: "The URL of the consumed offer.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"alias": {
+				Description: "The name of the SAAS application in the model. Defaults to the offer name.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"name": {
+				Description: "The name of the SAAS application in the model, to reference it in integrations.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"endpoints": {
+				Description: "The endpoints of the consumed offer.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"status": {
+				Description: "The status of the SAAS application.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceSAASCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*juju.Client)
+
+	modelName := d.Get("model").(string)
+	modelUUID, err := client.Models.ResolveModelUUID(modelName)
+	if err != nil {
+		return checkModelErr(err)
+	}
+
+	response, err := client.Offers.ConsumeRemoteOffer(&juju.ConsumeRemoteOfferInput{
+		ModelUUID: modelUUID,
+		OfferURL:  d.Get("offer_url").(string),
+		Alias:     d.Get("alias").(string),
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", modelName, response.SAASName))
+
+	return resourceSAASRead(ctx, d, meta)
+}
+
+func resourceSAASRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*juju.Client)
+
+	modelName, name, err := parseSAASID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	modelUUID, err := client.Models.ResolveModelUUID(modelName)
+	if err != nil {
+		return checkModelErr(err)
+	}
+
+	response, err := client.Offers.ReadSAAS(&juju.ReadSAASInput{
+		ModelUUID: modelUUID,
+		Name:      name,
+	})
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			// SAAS manually removed
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	if err = d.Set("model", modelName); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("offer_url", response.OfferURL); err != nil {
+		return diag.FromErr(err)
+	}
+	// the alias is only tracked when it differs from the offer name,
+	// or when it is explicitly set to the offer name
+	alias := ""
+	if response.Name != response.OfferName || d.Get("alias").(string) == response.Name {
+		alias = response.Name
+	}
+	if err = d.Set("alias", alias); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("name", response.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("endpoints", response.Endpoints); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("status", response.Status); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// Juju refers to deletion as "destroy" so we call the Destroy function of our client here rather than delete
+// This function remains named Delete for parity across the provider and to stick within terraform naming conventions
+func resourceSAASDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*juju.Client)
+
+	modelName, name, err := parseSAASID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	modelUUID, err := client.Models.ResolveModelUUID(modelName)
+	if err != nil {
+		return checkModelErr(err)
+	}
+
+	err = client.Offers.DestroySAAS(&juju.DestroySAASInput{
+		ModelUUID: modelUUID,
+		Name:      name,
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func parseSAASID(id string) (modelName string, name string, err error) {
+	parts := strings.Split(id, ":")
+	if len(parts) != 2 {
+		return "", "", fmt.Errorf("unable to parse model and SAAS name from provided ID %q, expected <model>:<saas name>", id)
+	}
+	return parts[0], parts[1], nil
+}
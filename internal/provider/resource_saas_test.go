@@ -0,0 +1,120 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAcc_ResourceSAAS(t *testing.T) {
+	srcModelName := acctest.RandomWithPrefix("tf-test-saas-src")
+	dstModelName := acctest.RandomWithPrefix("tf-test-saas-dst")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceSAAS(srcModelName, dstModelName, "database"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("juju_saas.this", "model", srcModelName),
+					resource.TestCheckResourceAttr("juju_saas.this", "name", "database"),
+					resource.TestCheckResourceAttr("juju_saas.this", "endpoints.#", "1"),
+					resource.TestCheckResourceAttr("juju_saas.this", "endpoints.0", "backend-db-admin"),
+					resource.TestCheckResourceAttr("juju_saas.this", "id", fmt.Sprintf("%s:database", srcModelName)),
+					resource.TestCheckTypeSetElemNestedAttrs("juju_integration.this", "application.*", map[string]string{"name": "database", "endpoint": "backend-db-admin"}),
+				),
+			},
+			{
+				ImportStateVerify: true,
+				ImportState:       true,
+				ResourceName:      "juju_saas.this",
+			},
+		},
+	})
+}
+
+func TestAcc_ResourceSAAS_OfferNameAlias(t *testing.T) {
+	srcModelName := acctest.RandomWithPrefix("tf-test-saas-src")
+	dstModelName := acctest.RandomWithPrefix("tf-test-saas-dst")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				// the offer is named after the application
+				Config: testAccResourceSAAS(srcModelName, dstModelName, "pgbouncer"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("juju_saas.this", "alias", "pgbouncer"),
+					resource.TestCheckResourceAttr("juju_saas.this", "name", "pgbouncer"),
+				),
+			},
+			{
+				// an alias set to the offer name does not recreate the SAAS
+				Config:   testAccResourceSAAS(srcModelName, dstModelName, "pgbouncer"),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func testAccResourceSAAS(srcModelName string, dstModelName string, alias string) string {
+	return fmt.Sprintf(`
+resource "juju_model" "src" {
+	name = %q
+}
+
+resource "juju_application" "src" {
+	model = juju_model.src.name
+	name  = "postgresql"
+
+	charm {
+		name = "postgresql"
+		series = "focal"
+	}
+}
+
+resource "juju_model" "dst" {
+	name = %q
+}
+
+resource "juju_application" "dst" {
+	model = juju_model.dst.name
+	name  = "pgbouncer"
+
+	charm {
+		name = "pgbouncer"
+		series = "focal"
+	}
+}
+
+resource "juju_offer" "dst" {
+	model            = juju_model.dst.name
+	application_name = juju_application.dst.name
+	endpoint         = "backend-db-admin"
+}
+
+resource "juju_saas" "this" {
+	model     = juju_model.src.name
+	offer_url = juju_offer.dst.url
+	alias     = %q
+}
+
+resource "juju_integration" "this" {
+	model = juju_model.src.name
+
+	application {
+		name     = juju_application.src.name
+		endpoint = "db-admin"
+	}
+
+	application {
+		name     = juju_saas.this.name
+		endpoint = "backend-db-admin"
+	}
+}
+`, srcModelName, dstModelName, alias)
+}
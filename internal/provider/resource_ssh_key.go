@@ -7,8 +7,9 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/juju/errors"
 	"github.com/juju/terraform-provider-juju/internal/juju"
-	"github.com/juju/terraform-provider-juju/internal/utils"
+	"github.com/juju/utils/v3/ssh"
 )
 
 func resourceSSHKey() *schema.Resource {
@@ -18,29 +19,88 @@ func resourceSSHKey() *schema.Resource {
 
 		CreateContext: sshKeyCreate,
 		ReadContext:   sshKeyRead,
-		UpdateContext: sshKeyUpdate,
 		DeleteContext: sshKeyDelete,
 
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{{
+			Version: 0,
+			Type:    resourceSSHKeyV0().CoreConfigSchema().ImpliedType(),
+			Upgrade: resourceSSHKeyStateUpgradeV0,
+		}},
+
 		Schema: map[string]*schema.Schema{
 			"model": {
-				Description: "The name of the model to operate in.",
+				Description: "The name of the model to operate in, qualified with its owner as `owner/name` for models owned by other users.",
 				Type:        schema.TypeString,
 				Required:    true,
+				ForceNew:    true,
 			},
 			"payload": {
-				Description: "SSH key payload.",
+				Description:  "SSH key payload.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateSSHKey,
+			},
+			"fingerprint": {
+				Description: "The fingerprint of the key, identifying it in the model.",
 				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
+				Computed:    true,
+			},
+			"comment": {
+				Description: "The comment of the key, usually the user owning it. Several keys may share a comment.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// resourceSSHKeyV0 is the ssh key resource identified by
+// "sshkey:<model>:<comment of the key>".
+func resourceSSHKeyV0() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"model": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"payload": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
 			},
 		},
 	}
 }
 
+func validateSSHKey(value interface{}, key string) ([]string, []error) {
+	if _, _, err := ssh.KeyFingerprint(value.(string)); err != nil {
+		return nil, []error{fmt.Errorf("%q must be a valid SSH public key: %s", key, err)}
+	}
+	return nil, nil
+}
+
+// newSSHKeyID returns the ID of the key, in the format
+// "sshkey:<model>:<fingerprint>".
+func newSSHKeyID(modelName, fingerprint string) string {
+	return fmt.Sprintf("sshkey:%s:%s", modelName, fingerprint)
+}
+
+// parseSSHKeyID returns the model and the fingerprint of the key. The
+// fingerprint contains colons, so only the first two are separators.
+func parseSSHKeyID(id string) (string, string, error) {
+	tokens := strings.SplitN(id, ":", 3)
+	if len(tokens) != 3 || tokens[0] != "sshkey" || tokens[1] == "" || tokens[2] == "" {
+		return "", "", fmt.Errorf("unable to parse model and fingerprint from ID %q, expected sshkey:<model>:<fingerprint>", id)
+	}
+	return tokens[1], tokens[2], nil
+}
+
 func sshKeyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*juju.Client)
 
@@ -49,14 +109,14 @@ func sshKeyCreate(ctx context.Context, d *schema.ResourceData, meta interface{})
 	modelName := d.Get("model").(string)
 	modelUUID, err := client.Models.ResolveModelUUID(modelName)
 	if err != nil {
-		return diag.FromErr(err)
+		return checkModelErr(err)
 	}
 
 	payload := d.Get("payload").(string)
 
-	user := utils.GetUserFromSSHKey(payload)
-	if user == "" {
-		return diag.Errorf("malformed SSH key, user not found")
+	fingerprint, _, err := ssh.KeyFingerprint(payload)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	err = client.SSHKeys.CreateSSHKey(&juju.CreateSSHKeyInput{
@@ -64,34 +124,37 @@ func sshKeyCreate(ctx context.Context, d *schema.ResourceData, meta interface{})
 		ModelUUID: modelUUID,
 		Payload:   payload,
 	})
-
 	if err != nil {
-		diags = append(diags, diag.FromErr(err)...)
+		return diag.FromErr(err)
 	}
 
-	d.SetId(fmt.Sprintf("sshkey:%s:%s", modelName, user))
+	d.SetId(newSSHKeyID(modelName, fingerprint))
 
-	return diags
+	return append(diags, sshKeyRead(ctx, d, meta)...)
 }
 
 func sshKeyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*juju.Client)
 
-	// sshkey:model:user
-	tokens := strings.Split(d.Id(), ":")
-	modelName := tokens[1]
-	user := tokens[2]
-
-	modelUUID, err := client.Models.GetModelByName(modelName)
+	modelName, fingerprint, err := parseSSHKeyID(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
+	modelUUID, err := client.Models.ResolveModelUUID(modelName)
+	if err != nil {
+		return checkModelErr(err)
+	}
+
 	result, err := client.SSHKeys.ReadSSHKey(&juju.ReadSSHKeyInput{
-		ModelName: modelUUID.Name,
-		ModelUUID: modelUUID.UUID,
-		User:      user,
+		ModelName:   modelName,
+		ModelUUID:   modelUUID,
+		Fingerprint: fingerprint,
 	})
+	if errors.Is(err, errors.NotFound) {
+		d.SetId("")
+		return nil
+	}
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -99,89 +162,62 @@ func sshKeyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 	if err = d.Set("model", result.ModelName); err != nil {
 		return diag.FromErr(err)
 	}
-
 	if err = d.Set("payload", result.Payload); err != nil {
 		return diag.FromErr(err)
 	}
-
-	d.SetId(fmt.Sprintf("sshkey:%s:%s", modelName, user))
+	if err = d.Set("fingerprint", result.Fingerprint); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("comment", result.Comment); err != nil {
+		return diag.FromErr(err)
+	}
 
 	return diag.Diagnostics{}
 }
 
-func sshKeyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	var diags diag.Diagnostics
-
+func sshKeyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*juju.Client)
 
-	if !d.HasChange("payload") {
-		return diags
-	}
+	var diags diag.Diagnostics
 
-	// any change in the payload has to be considered as a new key
-	modelName := d.Get("model").(string)
-	modelUUID, err := client.Models.ResolveModelUUID(modelName)
+	modelName, fingerprint, err := parseSSHKeyID(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	user := utils.GetUserFromSSHKey(d.Get("payload").(string))
-	if user == "" {
-		return diag.Errorf("malformed SSH key, user not found")
+	modelUUID, err := client.Models.ResolveModelUUID(modelName)
+	if err != nil {
+		return checkModelErr(err)
 	}
 
-	// delete
 	err = client.SSHKeys.DeleteSSHKey(&juju.DeleteSSHKeyInput{
-		ModelName: modelName,
-		ModelUUID: modelUUID,
-		User:      user,
+		ModelName:   modelName,
+		ModelUUID:   modelUUID,
+		Fingerprint: fingerprint,
 	})
 	if err != nil {
-		diags = diag.FromErr(err)
-		return diags
+		diags = append(diags, diag.FromErr(err)...)
 	}
 
-	// create again
-	err = client.SSHKeys.CreateSSHKey(&juju.CreateSSHKeyInput{
-		ModelName: modelName,
-		ModelUUID: modelUUID,
-		Payload:   d.Get("payload").(string),
-	})
-	if err != nil {
-		diags = diag.FromErr(err)
-		return diags
-	}
+	d.SetId("")
 
 	return diags
 }
 
-func sshKeyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*juju.Client)
-
-	var diags diag.Diagnostics
-
-	modelName := d.Get("model").(string)
-	modelUUID, err := client.Models.GetModelByName(modelName)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	user := utils.GetUserFromSSHKey(d.Get("payload").(string))
-	if user == "" {
-		return diag.Errorf("malformed SSH key, user not found")
-	}
-
-	err = client.SSHKeys.DeleteSSHKey(&juju.DeleteSSHKeyInput{
-		ModelName: modelUUID.Name,
-		ModelUUID: modelUUID.UUID,
-		User:      user,
-	})
+// resourceSSHKeyStateUpgradeV0 replaces the previous ID, derived from
+// the comment of the key, with one derived from its fingerprint.
+func resourceSSHKeyStateUpgradeV0(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	modelName, _ := rawState["model"].(string)
+	payload, _ := rawState["payload"].(string)
 
+	fingerprint, comment, err := ssh.KeyFingerprint(payload)
 	if err != nil {
-		diags = append(diags, diag.FromErr(err)...)
+		return nil, err
 	}
 
-	d.SetId("")
+	rawState["id"] = newSSHKeyID(modelName, fingerprint)
+	rawState["fingerprint"] = fingerprint
+	rawState["comment"] = comment
 
-	return diags
+	return rawState, nil
 }
@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -12,7 +13,7 @@ func TestAcc_ResourceSSHKey_Basic(t *testing.T) {
 	modelName := acctest.RandomWithPrefix("tf-test-sshkey")
 	sshKey1 := `ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABgQC1I8QDP79MaHEIAlfh933zqcE8LyUt9doytF3YySBUDWippk8MAaKAJJtNb+Qsi+Kx/RsSY02VxMy9xRTp9d/Vr+U5BctKqhqf3ZkJdTIcy+z4hYpFS8A4bECJFHOnKIekIHD9glHkqzS5Vm6E4g/KMNkKylHKlDXOafhNZAiJ1ynxaZIuedrceFJNC47HnocQEtusPKpR09HGXXYhKMEubgF5tsTO4ks6pplMPvbdjxYcVOg4Wv0N/LJ4ffAucG9edMcKOTnKqZycqqZPE6KsTpSZMJi2Kl3mBrJE7JbR1YMlNwG6NlUIdIqVoTLZgLsTEkHqWi6OExykbVTqFuoWJJY2BmRAcP9T3FdLYbqcajfWshwvPM2AmYb8V3zBvzEKL1rpvG26fd3kGhk3Vu07qAUhHLMi3P0McEky4cLiEWgI7UyHFLI2yMRZgz23UUtxhRSkvCJagRlVG/s4yoylzBQJir8G3qmb36WjBXxpqAXhfLxw05EQI1JGV3ReYOs= jimmy@somewhere`
 
-	sshKey2 := `ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABgQC1I8QDP79MaHEIAlfh933zqcE8LyUt9doytF3YySBUDWippk8MAaKAJJtNb+Qsi+Kx/RsSY02VxMy9xRTp9d/Vr+U5BctKqhqf3ZkJdTIcy+z4hYpFS8A4bECJFHOnKIekIHD9glHkqzS5Vm6E4g/KMNkKylHKlDXOafhNZAiJ1ynxaZIuedrceFJNC47HnocQEtusPKpR09HGXXYhKMEubgF5tsTO4ks6pplMPvbdjxYcVOg4Wv0N/LJ4ffAucG9edMcKOTnKqZycqqZPE6KsTpSZMJi2Kl3mBrJE7JbR1YMlNwG6NlUIdIqVoTLZgLsTEkHqWi6OExykbVTqFuoWJJY2BmRAcP9T3FdLYbqcajfWshwvPM2AmYb8V3zBvzEKL1rpvG26fd3kGhk3Vu07qAUhHLMi3P0McEky4cLiEWgI7UyHFLI2yMRZgz23UUtxhRSkvCJagRlVG/s4yoylzBQJir8G3qmb36WjBXxpqAXhfLxw05EQI1JGV3ReYOs= jimmy@somewhere`
+	sshKey2 := `ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIJFEnRAeBXhtlaDjEx94dwtjYXdjbWdl3cp8rvjBtN7W jimmy@somewhere`
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:          func() { testAccPreCheck(t) },
@@ -29,7 +30,14 @@ func TestAcc_ResourceSSHKey_Basic(t *testing.T) {
 				Config: testAccResourceSSHKey(modelName, sshKey2),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("juju_ssh_key.this", "model", modelName),
-					resource.TestCheckResourceAttr("juju_ssh_key.this", "payload", sshKey2)),
+					resource.TestCheckResourceAttr("juju_ssh_key.this", "payload", sshKey2),
+					resource.TestCheckResourceAttr("juju_ssh_key.this", "fingerprint", "00:1b:88:df:7b:ff:57:ab:91:ac:47:ff:94:2d:b0:94"),
+					resource.TestCheckResourceAttr("juju_ssh_key.this", "comment", "jimmy@somewhere")),
+			},
+			{
+				ImportStateVerify: true,
+				ImportState:       true,
+				ResourceName:      "juju_ssh_key.this",
 			},
 		},
 	})
@@ -53,6 +61,48 @@ func TestAcc_ResourceSSHKey_ED25519(t *testing.T) {
 	})
 }
 
+func TestParseSSHKeyID(t *testing.T) {
+	modelName, fingerprint, err := parseSSHKeyID("sshkey:admin/development:00:1b:88:df:7b:ff:57:ab:91:ac:47:ff:94:2d:b0:94")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if modelName != "admin/development" {
+		t.Errorf("expected model %q, got %q", "admin/development", modelName)
+	}
+	if fingerprint != "00:1b:88:df:7b:ff:57:ab:91:ac:47:ff:94:2d:b0:94" {
+		t.Errorf("expected fingerprint %q, got %q", "00:1b:88:df:7b:ff:57:ab:91:ac:47:ff:94:2d:b0:94", fingerprint)
+	}
+
+	for _, id := range []string{"", "sshkey:development", "ssh_key:development:dev-user", "sshkey::00:1b"} {
+		if _, _, err := parseSSHKeyID(id); err == nil {
+			t.Errorf("expected an error parsing %q", id)
+		}
+	}
+}
+
+func TestResourceSSHKeyStateUpgradeV0(t *testing.T) {
+	rawState := map[string]interface{}{
+		"id":      "sshkey:development:jimmy@somewhere",
+		"model":   "development",
+		"payload": "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIJFEnRAeBXhtlaDjEx94dwtjYXdjbWdl3cp8rvjBtN7W jimmy@somewhere",
+	}
+
+	state, err := resourceSSHKeyStateUpgradeV0(context.Background(), rawState, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := map[string]string{
+		"id":          "sshkey:development:00:1b:88:df:7b:ff:57:ab:91:ac:47:ff:94:2d:b0:94",
+		"fingerprint": "00:1b:88:df:7b:ff:57:ab:91:ac:47:ff:94:2d:b0:94",
+		"comment":     "jimmy@somewhere",
+	}
+	for k, v := range expected {
+		if state[k] != v {
+			t.Errorf("expected %s %q, got %q", k, v, state[k])
+		}
+	}
+}
+
 func testAccResourceSSHKey(modelName string, sshKey string) string {
 	return fmt.Sprintf(`
 resource "juju_model" "this" {
@@ -11,7 +11,8 @@ import (
 )
 
 // The User resource maps to a juju user that is operated via
-// `juju add-user`, `juju remove-user`
+// `juju add-user`, `juju remove-user`, `juju enable-user` and
+// `juju disable-user`.
 // Display name is optional.
 func resourceUser() *schema.Resource {
 	return &schema.Resource{
@@ -25,6 +26,7 @@ func resourceUser() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: resourceUserCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -34,14 +36,28 @@ func resourceUser() *schema.Resource {
 				ForceNew:    true,
 			},
 			"display_name": {
-				Description: "The display name to be assigned to the user",
+				Description: "The display name to be assigned to the user. Juju does not support changing it once the user is created.",
 				Type:        schema.TypeString,
 				Optional:    true,
+				Computed:    true,
 			},
 			"password": {
-				Description: "The password to be assigned to the user",
+				Description: "The password to be assigned to the user. Without a password, the user registers with the `registration_string`.",
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"registration_string": {
+				Description: "The string to pass to `juju register` for a user created, or whose password was removed, without a password.",
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"disabled": {
+				Description: "Whether the user is disabled, a disabled user can not log in.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
 			},
 		},
 	}
@@ -56,16 +72,20 @@ func resourceUserCreate(ctx context.Context, d *schema.ResourceData, meta interf
 	displayName := d.Get("display_name").(string)
 	password := d.Get("password").(string)
 
-	_, err := client.Users.CreateUser(juju.CreateUserInput{
+	response, err := client.Users.CreateUser(juju.CreateUserInput{
 		Name:        name,
 		DisplayName: displayName,
 		Password:    password,
+		Disabled:    d.Get("disabled").(bool),
 	})
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	d.SetId(fmt.Sprintf("user:%s", name))
+	if err = d.Set("registration_string", response.RegistrationString); err != nil {
+		return diag.FromErr(err)
+	}
 
 	return diags
 }
@@ -88,6 +108,9 @@ func resourceUserRead(ctx context.Context, d *schema.ResourceData, meta interfac
 	if err := d.Set("display_name", response.UserInfo.DisplayName); err != nil {
 		return diag.FromErr(err)
 	}
+	if err := d.Set("disabled", response.UserInfo.Disabled); err != nil {
+		return diag.FromErr(err)
+	}
 
 	return diags
 }
@@ -99,12 +122,19 @@ func resourceUserUpdate(ctx context.Context, d *schema.ResourceData, meta interf
 	anyChange := false
 
 	var newPassword string
-
-	var err error
+	var resetPassword bool
+	var disabled *bool
 
 	if d.HasChange("password") {
 		anyChange = true
 		newPassword = d.Get("password").(string)
+		// removing the password resets it, the user registers again
+		resetPassword = newPassword == ""
+	}
+	if d.HasChange("disabled") {
+		anyChange = true
+		newDisabled := d.Get("disabled").(bool)
+		disabled = &newDisabled
 	}
 
 	if !anyChange {
@@ -113,17 +143,36 @@ func resourceUserUpdate(ctx context.Context, d *schema.ResourceData, meta interf
 
 	id := strings.Split(d.Id(), ":")
 	name := id[1]
-	err = client.Users.UpdateUser(juju.UpdateUserInput{
-		Name:     name,
-		Password: newPassword,
+	response, err := client.Users.UpdateUser(juju.UpdateUserInput{
+		Name:          name,
+		Password:      newPassword,
+		ResetPassword: resetPassword,
+		Disabled:      disabled,
 	})
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
+	if newPassword != "" || resetPassword {
+		if err = d.Set("registration_string", response.RegistrationString); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return diags
 }
 
+// resourceUserCustomizeDiff refuses to change the display name of an
+// existing user, which Juju does not support.
+func resourceUserCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || !d.HasChange("display_name") {
+		return nil
+	}
+	oldName, newName := d.GetChange("display_name")
+	return fmt.Errorf("cannot change the display name of user %s from %q to %q, Juju does not support it: recreate the user to change it",
+		d.Get("name").(string), oldName, newName)
+}
+
 // Juju refers to user deletion as "destroy" so we call the Destroy function of our client here rather than delete
 // This function remains named Delete for parity across the provider and to stick within terraform naming conventions
 func resourceUserDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
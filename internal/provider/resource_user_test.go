@@ -2,6 +2,7 @@ package provider
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -26,7 +27,7 @@ func TestAcc_ResourceUser_Basic(t *testing.T) {
 			{
 				ImportStateVerify:       true,
 				ImportState:             true,
-				ImportStateVerifyIgnore: []string{"password"},
+				ImportStateVerifyIgnore: []string{"password", "registration_string"},
 				ImportStateId:           fmt.Sprintf("user:%s", userName),
 				ResourceName:            resourceName,
 			},
@@ -34,6 +35,53 @@ func TestAcc_ResourceUser_Basic(t *testing.T) {
 	})
 }
 
+func TestAcc_ResourceUser_Registration(t *testing.T) {
+	userName := acctest.RandomWithPrefix("tfuser")
+	userPassword := acctest.RandomWithPrefix("tf-test-user")
+
+	resourceName := "juju_user.user"
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceUserWithoutPassword(userName, "Test User", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "display_name", "Test User"),
+					resource.TestCheckResourceAttr(resourceName, "disabled", "false"),
+					resource.TestMatchResourceAttr(resourceName, "registration_string", regexp.MustCompile("^[A-Za-z0-9_-]+$")),
+				),
+			},
+			{
+				Config: testAccResourceUserWithoutPassword(userName, "Test User", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "disabled", "true"),
+				),
+			},
+			{
+				Config: testAccResourceUser(t, userName, userPassword),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "disabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "registration_string", ""),
+				),
+			},
+			{
+				Config:      testAccResourceUserWithoutPassword(userName, "Renamed User", false),
+				ExpectError: regexp.MustCompile("cannot change the display name"),
+			},
+		},
+	})
+}
+
+func testAccResourceUserWithoutPassword(userName, displayName string, disabled bool) string {
+	return fmt.Sprintf(`
+resource "juju_user" "user" {
+  name = %q
+  display_name = %q
+  disabled = %t
+}`, userName, displayName, disabled)
+}
+
 func testAccResourceUser(t *testing.T, userName, userPassword string) string {
 	return fmt.Sprintf(`
 resource "juju_user" "user" {